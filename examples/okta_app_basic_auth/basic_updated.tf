@@ -0,0 +1,13 @@
+resource "okta_group" "group" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_basic_auth" "test" {
+  label              = "testAcc_replace_with_uuid"
+  url                = "https://example.com/login-updated.html"
+  auth_url           = "https://example.com/auth-updated.html"
+  credentials_scheme = "SHARED_USERNAME_AND_PASSWORD"
+  shared_username    = "sharedusername"
+  shared_password    = "sharedpassword"
+  groups             = ["${okta_group.group.id}"]
+}
@@ -0,0 +1,8 @@
+resource "okta_app_bookmark" "test" {
+  label          = "testAcc_replace_with_uuid"
+  url            = "https://test.com"
+  logo           = "../examples/okta_app_bookmark/logo.png"
+  app_links_json = "{\"login\": true}"
+  admin_note     = "Managed by Terraform"
+  enduser_note   = "Reach out to the help desk for access"
+}
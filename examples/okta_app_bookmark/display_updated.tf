@@ -0,0 +1,7 @@
+resource "okta_app_bookmark" "test" {
+  label          = "testAcc_replace_with_uuid"
+  url            = "https://test.com"
+  logo           = "../examples/okta_app_bookmark/logo_updated.png"
+  app_links_json = "{\"login\": false}"
+  admin_note     = "Managed by Terraform, do not edit"
+}
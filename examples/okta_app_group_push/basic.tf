@@ -0,0 +1,23 @@
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_saml" "test" {
+  label             = "testAcc_replace_with_uuid"
+  preconfigured_app = "scim2testapp"
+  groups            = ["${okta_group.test.id}"]
+}
+
+resource "okta_app_provisioning" "test" {
+  app_id   = "${okta_app_saml.test.id}"
+  base_url = "https://scim.example.com/scim/v2"
+  token    = "testAcc_replace_with_uuid"
+  features = ["PUSH_NEW_USERS", "PUSH_PROFILE_UPDATES"]
+}
+
+resource "okta_app_group_push" "test" {
+  app_id              = "${okta_app_provisioning.test.app_id}"
+  source_group_id     = "${okta_group.test.id}"
+  target_group_name   = "testAcc_replace_with_uuid"
+  delete_target_group = true
+}
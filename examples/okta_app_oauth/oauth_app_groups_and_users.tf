@@ -18,7 +18,10 @@ resource "okta_app_oauth" "test" {
   redirect_uris             = ["http://d.com/"]
   post_logout_redirect_uris = ["http://d.com/post"]
   login_uri                 = "http://test.com"
+  login_mode                = "OKTA"
+  login_scopes              = ["openid", "email"]
   response_types            = ["code", "token", "id_token"]
+  admin_note                = "Managed by Terraform"
 
   users = {
     id       = "${okta_user.user.id}"
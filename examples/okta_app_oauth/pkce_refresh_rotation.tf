@@ -0,0 +1,11 @@
+resource "okta_app_oauth" "test" {
+  label                      = "testAcc_replace_with_uuid"
+  type                       = "native"
+  grant_types                = ["authorization_code", "refresh_token"]
+  redirect_uris              = ["http://d.com/"]
+  response_types             = ["code"]
+  token_endpoint_auth_method = "none"
+  pkce_required              = true
+  refresh_token_rotation     = "ROTATE"
+  refresh_token_leeway       = 60
+}
@@ -0,0 +1,14 @@
+resource "okta_app_oauth" "test" {
+  label                      = "testAcc_replace_with_uuid"
+  type                       = "service"
+  response_types             = ["token"]
+  grant_types                = ["client_credentials"]
+  token_endpoint_auth_method = "private_key_jwt"
+
+  jwks {
+    kid = "SIGNING_KEY"
+    kty = "RSA"
+    e   = "AQAB"
+    n   = "oMH__qnK72HwS3Wjl_1-I3T2IJAmr2ybnrLcT1bEz29Yiyom9kWBGg90TqA3CWb37sBIZmNsTL4q3jaopqDgoi_DobGapE5NrfnTAUPCk7SlIFCaJ6Dy4hjW17XTZm6zfaTbeM5AAyYtlTEEPyUXeavYvk6aGTMkvmto7kBpMFNQ9XNihIrB-b2Ic8oLXMubSx1UtzVcM1H6JTvUemVWo-BnVcZM5Ja9CWgSXrbuMgRABkWHThdbJZTgd0dRPQphDhh5LByv1JeYFqlN2BV_VMaDaYREZ8M2qgA7kKQ-tlFpUeG6t3cbza84svNLzHz-HSE2LQB1DrB69qZVT8f24Q"
+  }
+}
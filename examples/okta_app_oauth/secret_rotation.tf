@@ -0,0 +1,8 @@
+resource "okta_app_oauth" "test" {
+  label                          = "testAcc_replace_with_uuid"
+  type                           = "web"
+  grant_types                    = ["authorization_code"]
+  redirect_uris                  = ["http://d.com/"]
+  response_types                 = ["code"]
+  client_secret_rotation_trigger = "1"
+}
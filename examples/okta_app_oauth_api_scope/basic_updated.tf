@@ -0,0 +1,11 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "service"
+  response_types = ["token"]
+  grant_types    = ["client_credentials"]
+}
+
+resource "okta_app_oauth_api_scope" "test" {
+  app_id = "${okta_app_oauth.test.id}"
+  scopes = ["okta.users.read", "okta.groups.manage", "okta.apps.read"]
+}
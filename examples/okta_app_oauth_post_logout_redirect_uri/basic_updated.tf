@@ -0,0 +1,23 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  response_types = ["code"]
+  redirect_uris  = ["myapp://callback"]
+
+  // Managed by okta_app_oauth_post_logout_redirect_uri below
+  lifecycle {
+    ignore_changes = ["post_logout_redirect_uris"]
+  }
+}
+
+// Both URIs are added to the same application in a single apply
+resource "okta_app_oauth_post_logout_redirect_uri" "test" {
+  app_id = "${okta_app_oauth.test.id}"
+  uri    = "http://google-updated.com"
+}
+
+resource "okta_app_oauth_post_logout_redirect_uri" "test2" {
+  app_id = "${okta_app_oauth.test.id}"
+  uri    = "http://google.com/other"
+}
@@ -0,0 +1,17 @@
+resource "okta_app_saml" "test" {
+  label             = "testAcc_replace_with_uuid"
+  preconfigured_app = "scim2testapp"
+}
+
+resource "okta_app_provisioning" "test" {
+  app_id   = "${okta_app_saml.test.id}"
+  base_url = "https://scim.example.com/scim/v2"
+  token    = "testAcc_replace_with_uuid"
+  features = ["PUSH_NEW_USERS", "PUSH_PROFILE_UPDATES", "PUSH_USER_DEACTIVATION", "IMPORT_NEW_USERS"]
+
+  import_schedule {
+    full_import        = "0 0 * * 0"
+    incremental_import = "0 * * * *"
+    timezone           = "America/New_York"
+  }
+}
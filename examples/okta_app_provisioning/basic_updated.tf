@@ -0,0 +1,12 @@
+resource "okta_app_saml" "test" {
+  label             = "testAcc_replace_with_uuid"
+  preconfigured_app = "scim2testapp"
+}
+
+resource "okta_app_provisioning" "test" {
+  app_id        = "${okta_app_saml.test.id}"
+  base_url      = "https://scim.example.com/scim/v2"
+  token         = "testAcc_replace_with_uuid_updated"
+  features      = ["PUSH_NEW_USERS", "PUSH_PASSWORD_UPDATES"]
+  password_seed = "RANDOM"
+}
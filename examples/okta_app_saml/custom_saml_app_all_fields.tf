@@ -17,12 +17,20 @@ resource "okta_app_saml" "testAcc_replace_with_uuid" {
     {
       name      = "Attr One"
       namespace = "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"
-      values    = ["val"]
+      values    = ["val", "$${user.email}"]
     },
     {
       name         = "Attr Two"
+      type         = "GROUP"
       filter_type  = "STARTS_WITH"
       filter_value = "test"
     },
+    {
+      name         = "Attr Three"
+      namespace    = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+      type         = "GROUP"
+      filter_type  = "REGEX"
+      filter_value = "^(admins|ops)-.*$"
+    },
   ]
 }
@@ -0,0 +1,56 @@
+resource "okta_app_saml" "testAcc_replace_with_uuid" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "http://google.com"
+  recipient                = "http://here.com"
+  destination              = "http://its-about-the-journey.com"
+  audience                 = "http://audience.com"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+
+  slo_url         = "http://google.com/logout"
+  slo_issuer      = "http://google.com"
+  slo_certificate = "${local.sp_certificate}"
+
+  assertion_encryption_algorithm    = "AES256_CBC"
+  assertion_key_transport_algorithm = "RSA_OAEP"
+  assertion_encryption_certificate  = "${local.sp_certificate}"
+
+  acs_endpoints {
+    url   = "http://google.com/acs"
+    index = 0
+  }
+
+  acs_endpoints {
+    url   = "http://google.com/acs/other"
+    index = 1
+  }
+}
+
+locals {
+  sp_certificate = <<CERT
+-----BEGIN CERTIFICATE-----
+MIIDEzCCAfugAwIBAgIUfkYevc0g9+dvDOm8oJtgQ9oFJjEwDQYJKoZIhvcNAQEL
+BQAwGTEXMBUGA1UEAwwOc3AuZXhhbXBsZS5jb20wHhcNMjYxMDE5MDU0MDU1WhcN
+MzYxMDE2MDU0MDU1WjAZMRcwFQYDVQQDDA5zcC5leGFtcGxlLmNvbTCCASIwDQYJ
+KoZIhvcNAQEBBQADggEPADCCAQoCggEBALJEppmTVd7SoC2VnPHXyB0Zkmr7C2DI
+IDfv0hJDdJ0UQXkjzO/wPVSlaSjReiRzYNBell16TPX9QfczyoPIjfLdAYD5DdFG
+28ermoJMDJX96KYMIjwiy8O91BKRqirSb+pYV4o65JdvOEXHNPA2ZAyDaLKiPzT7
+N/G1SOeS8w+VJ1NJb6IwJ3GOeZf9sRCv4zvFyBISUo6my2N0uiHW13/TXPdh7gVy
+wgDYVoNUto0my9FmGELQaP/Or7aCFH8bKwCjs8ZOsgoxMaGddf1vOA9XUSry7D8T
+FFDygd0bQqtqGRJqVGCEkxgxOWv4tskFpf0JAhiklubB1dOkC+CYI9ECAwEAAaNT
+MFEwHQYDVR0OBBYEFN6UUCMYbdHWenXeyQmdV0q0T+2jMB8GA1UdIwQYMBaAFN6U
+UCMYbdHWenXeyQmdV0q0T+2jMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQEL
+BQADggEBAAASvPMkCCr9Y7SgEcKVgvMxmsoJf2BnihJX2IJBoji8QiEYIb2qcn8l
+oIPVvCI/OvFqXZu8/m15oUWN5AdGX1mL7v9h56Bk6PUQah8Nk2zvQAM8atSHtmmA
+v1YRbtLFYyzBifBmFfWvZJyp8MREAJbPJqJArWF6PHp/0ZmIjnRP/qjmyLjl5Ssr
+BAYULR0dy9+2pxLf5YHwcmSx30RwLcppc2SeBo+mY2PcsRVBS6tb8yJzsP7HIMQ5
+iacYzdKnYkz2W8BR3e4XszNKZo3xy+TEA/Uigx1N5LS9wTlug2Si8WYLMiM3ySR3
+1WZp++AysxMASpgEaBwPlxdz+cBMdSI=
+-----END CERTIFICATE-----
+CERT
+}
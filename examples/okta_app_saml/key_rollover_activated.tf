@@ -0,0 +1,21 @@
+resource "okta_app_saml" "testAcc_replace_with_uuid" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "http://google.com"
+  recipient                = "http://here.com"
+  destination              = "http://its-about-the-journey.com"
+  audience                 = "http://audience.com"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+  key_name                 = "testAcc_replace_with_uuid"
+  key_years_valid          = 2
+  pending_key_name         = "testAcc_replace_with_uuid_next"
+
+  // pending_key_id of the previous apply, read from the file it was saved to. Interpolating it would make the
+  // resource reference itself.
+  active_key_id = "${trimspace(file("replace_with_pending_key_file"))}"
+}
@@ -0,0 +1,23 @@
+resource "okta_app_saml" "test" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "http://google.com"
+  recipient                = "http://here.com"
+  destination              = "http://its-about-the-journey.com"
+  audience                 = "http://audience.com"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+data "okta_app_saml_metadata" "test" {
+  app_id = "${okta_app_saml.test.id}"
+}
+
+data "okta_app_saml_metadata" "test_key" {
+  app_id = "${okta_app_saml.test.id}"
+  key_id = "${okta_app_saml.test.key_id}"
+}
@@ -0,0 +1,6 @@
+resource "okta_app_ws_federation" "test" {
+  label     = "testAcc_replace_with_uuid"
+  site_url  = "https://example.com"
+  realm     = "urn:example:testAcc_replace_with_uuid"
+  reply_url = "https://example.com/sso/wsfed"
+}
@@ -0,0 +1,13 @@
+resource "okta_app_ws_federation" "test" {
+  label                = "testAcc_replace_with_uuid"
+  site_url             = "https://example.com"
+  realm                = "urn:example:testAcc_replace_with_uuid"
+  reply_url            = "https://example.com/sso/wsfed-updated"
+  reply_override       = true
+  name_id_format       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  username_attribute   = "upn"
+  attribute_statements = "givenname|$${user.firstName}|,surname|$${user.lastName}|"
+  group_name           = "groups"
+  group_filter         = "^app-.*$"
+  group_value_format   = "samAccountName"
+}
@@ -0,0 +1,25 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["implicit", "authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code", "token", "id_token"]
+}
+
+resource "okta_app_bookmark" "test" {
+  label  = "testAcc_replace_with_uuid_bookmark"
+  url    = "https://test.com"
+  status = "INACTIVE"
+}
+
+data "okta_apps" "oidc" {
+  sign_on_mode = "OPENID_CONNECT"
+  label_regex  = "^${okta_app_oauth.test.label}$"
+}
+
+data "okta_apps" "inactive" {
+  status      = "INACTIVE"
+  label_regex = "^testAcc_replace_with_uuid"
+
+  depends_on = ["okta_app_bookmark.test"]
+}
@@ -0,0 +1,43 @@
+resource "okta_auth_server" "test" {
+  audiences   = ["whatever.rise.zone"]
+  description = "test"
+  name        = "testAcc_replace_with_uuid"
+}
+
+resource "okta_auth_server_scope" "test" {
+  name           = "test:something"
+  description    = "test"
+  auth_server_id = "${okta_auth_server.test.id}"
+}
+
+resource "okta_auth_server_claim" "test" {
+  name           = "test"
+  claim_type     = "RESOURCE"
+  value          = "user.email"
+  auth_server_id = "${okta_auth_server.test.id}"
+}
+
+resource "okta_auth_server_policy" "test" {
+  name             = "test"
+  description      = "test"
+  priority         = 1
+  client_whitelist = ["ALL_CLIENTS"]
+  auth_server_id   = "${okta_auth_server.test.id}"
+}
+
+data "okta_auth_server_scopes" "test" {
+  auth_server_id = "${okta_auth_server_scope.test.auth_server_id}"
+}
+
+data "okta_auth_server_claims" "test" {
+  auth_server_id = "${okta_auth_server_claim.test.auth_server_id}"
+}
+
+data "okta_auth_server_policy" "test" {
+  auth_server_id = "${okta_auth_server_policy.test.auth_server_id}"
+  name           = "${okta_auth_server_policy.test.name}"
+}
+
+data "okta_auth_server_metadata" "test" {
+  auth_server_id = "${okta_auth_server.test.id}"
+}
@@ -0,0 +1,7 @@
+resource "okta_auth_server" "sun_also_rises" {
+  audiences                 = ["whatever-else.rise.zone"]
+  credentials_rotation_mode = "MANUAL"
+  description               = "The past is not dead. In fact, it's not even past."
+  name                      = "testAcc_replace_with_uuid"
+  rotate_keys_trigger       = "1"
+}
@@ -0,0 +1,16 @@
+resource "okta_auth_server_claim" "test" {
+  name              = "groups"
+  status            = "ACTIVE"
+  claim_type        = "IDENTITY"
+  value_type        = "GROUPS"
+  group_filter_type = "REGEX"
+  value             = "^Every.*"
+  scopes            = ["openid"]
+  auth_server_id    = "${okta_auth_server.test.id}"
+}
+
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
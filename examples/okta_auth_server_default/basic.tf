@@ -0,0 +1,14 @@
+resource "okta_auth_server_default" "test" {
+  audiences   = ["api://default"]
+  description = "Default Authorization Server for your Applications"
+}
+
+resource "okta_auth_server_claim" "test" {
+  name              = "testAcc_replace_with_uuid"
+  status            = "ACTIVE"
+  claim_type        = "IDENTITY"
+  value_type        = "GROUPS"
+  group_filter_type = "STARTS_WITH"
+  value             = "testAcc_"
+  auth_server_id    = "${okta_auth_server_default.test.id}"
+}
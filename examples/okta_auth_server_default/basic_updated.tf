@@ -0,0 +1,4 @@
+resource "okta_auth_server_default" "test" {
+  audiences   = ["api://default"]
+  description = "testAcc_replace_with_uuid"
+}
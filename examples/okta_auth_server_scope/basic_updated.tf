@@ -1,6 +1,8 @@
 resource "okta_auth_server_scope" "test" {
-  consent        = "REQUIRED"
+  consent        = "FLEXIBLE"
   description    = "test_updated"
+  display_name   = "Something"
+  default        = true
   name           = "test:something"
   auth_server_id = "${okta_auth_server.test.id}"
 }
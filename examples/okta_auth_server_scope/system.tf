@@ -0,0 +1,12 @@
+resource "okta_auth_server_scope" "test" {
+  consent        = "REQUIRED"
+  description    = "Keep you signed in to the app"
+  name           = "offline_access"
+  auth_server_id = "${okta_auth_server.test.id}"
+}
+
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
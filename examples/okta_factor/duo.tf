@@ -0,0 +1,11 @@
+resource okta_factor duo {
+  provider_id = "duo"
+
+  duo {
+    integration_key = "DIXXXXXXXXXXXXXXXXXX"
+    secret_key      = "${var.duo_secret_key}"
+    api_host        = "api-xxxxxxxx.duosecurity.com"
+  }
+}
+
+variable duo_secret_key {}
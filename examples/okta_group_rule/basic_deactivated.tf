@@ -3,9 +3,10 @@ resource "okta_group" "test_other" {
 }
 
 resource "okta_group_rule" "test" {
-  name              = "testAcc_replace_with_uuid"
-  status            = "INACTIVE"
-  group_assignments = ["${okta_group.test_other.id}"]
-  expression_type   = "urn:okta:expression:1.0"
-  expression_value  = "String.startsWith(user.articulateId,\"auth0|\")"
+  name                  = "testAcc_replace_with_uuid"
+  status                = "INACTIVE"
+  group_assignments     = ["${okta_group.test_other.id}"]
+  expression_type       = "urn:okta:expression:1.0"
+  expression_value      = "String.startsWith(user.articulateId,\"auth0|\")"
+  remove_assigned_users = true
 }
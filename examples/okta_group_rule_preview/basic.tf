@@ -0,0 +1,13 @@
+resource okta_user test {
+  first_name = "TestAcc"
+  last_name  = "Preview"
+  login      = "preview_replace_with_uuid@example.com"
+  email      = "preview_replace_with_uuid@example.com"
+}
+
+data okta_group_rule_preview test {
+  expression_value = "String.startsWith(user.login,\"preview_replace_with_uuid\")"
+
+  # Make sure the user exists before previewing
+  depends_on = ["okta_user.test"]
+}
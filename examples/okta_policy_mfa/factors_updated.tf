@@ -0,0 +1,25 @@
+data okta_group all {
+  name = "Everyone"
+}
+
+resource okta_policy_mfa test {
+  name            = "testAcc_replace_with_uuid"
+  status          = "INACTIVE"
+  description     = "Terraform Acceptance Test MFA Policy Updated"
+  groups_included = ["${data.okta_group.all.id}"]
+
+  factor {
+    key    = "google_otp"
+    enroll = "OPTIONAL"
+  }
+
+  factor {
+    key    = "okta_otp"
+    enroll = "OPTIONAL"
+  }
+
+  factor {
+    key    = "okta_sms"
+    enroll = "REQUIRED"
+  }
+}
@@ -0,0 +1,13 @@
+resource okta_user test {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "test-acc-replace_with_uuid@example.com"
+  email      = "test-acc-replace_with_uuid@example.com"
+}
+
+resource okta_user_factor test {
+  user_id         = "${okta_user.test.id}"
+  factor_type     = "email"
+  factor_provider = "OKTA"
+  email           = "test-acc-replace_with_uuid@example.com"
+}
@@ -3,6 +3,7 @@ package okta
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -19,14 +20,21 @@ type (
 		Name        string `json:"name"`
 		Status      string `json:"status"`
 		Description string `json:"description"`
+		SignOnMode  string `json:"signOnMode"`
 	}
 
+	// ID, Label and LabelPrefix select apps by identity, any of them matching is enough. The other filters narrow the
+	// result down further, apps must match all of them.
 	appFilters struct {
 		ApiFilter         string
 		ID                string
 		Label             string
 		LabelPrefix       string
+		LabelRegex        *regexp.Regexp
+		Name              string
 		ShortCircuitCount int
+		SignOnMode        string
+		Status            string
 	}
 
 	searchResults struct {
@@ -55,6 +63,7 @@ var appUserResource = &schema.Resource{
 		"password": &schema.Schema{
 			Type:        schema.TypeString,
 			Optional:    true,
+			Sensitive:   true,
 			Description: "Password for user application.",
 		},
 	},
@@ -95,6 +104,28 @@ var baseAppSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "INACTIVE"}, false),
 		Description:  "Status of application.",
 	},
+	"logo": &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		StateFunc:    hashFile,
+		ValidateFunc: validateFileExists,
+		Description:  "Local path of the logo to upload, it is uploaded again when the content of the file changes.",
+	},
+	"logo_url": &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "URL of the logo of the application.",
+	},
+	"admin_note": &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Application notes for admins.",
+	},
+	"enduser_note": &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Application notes for end users.",
+	},
 }
 
 var appVisibilitySchema = map[string]*schema.Schema{
@@ -116,6 +147,14 @@ var appVisibilitySchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Do not display application icon to users",
 	},
+	"app_links_json": &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateDataJSON,
+		StateFunc:    normalizeDataJSON,
+		Description:  "Displayed app links in JSON format, keyed by link name. Okta defines the links of each app.",
+	},
 }
 
 var baseappSwaSchema = map[string]*schema.Schema{
@@ -131,33 +170,56 @@ var baseappSwaSchema = map[string]*schema.Schema{
 		Description:  "Custom error page URL",
 		ValidateFunc: validateIsURL,
 	},
-	"auto_submit_toolbar": &schema.Schema{
-		Type:        schema.TypeBool,
+	"user_name_template": &schema.Schema{
+		Type:        schema.TypeString,
 		Optional:    true,
-		Default:     false,
-		Description: "Display auto submit toolbar",
+		Computed:    true,
+		Description: "Username template",
 	},
-	"hide_ios": &schema.Schema{
-		Type:        schema.TypeBool,
+	"user_name_template_type": &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Username template type",
+		ValidateFunc: validation.StringInSlice([]string{"NONE", "CUSTOM", "BUILT_IN"}, false),
+	},
+	"user_name_template_suffix": &schema.Schema{
+		Type:        schema.TypeString,
 		Optional:    true,
-		Default:     false,
-		Description: "Do not display application icon on mobile app",
+		Description: "Username template suffix",
 	},
-	"hide_web": &schema.Schema{
+	"credentials_scheme": &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "EDIT_USERNAME_AND_PASSWORD",
+		ValidateFunc: validation.StringInSlice(
+			[]string{
+				"EDIT_USERNAME_AND_PASSWORD",
+				"ADMIN_SETS_CREDENTIALS",
+				"EDIT_PASSWORD_ONLY",
+				"EXTERNAL_PASSWORD_SYNC",
+				"SHARED_USERNAME_AND_PASSWORD",
+			},
+			false,
+		),
+		Description: "Application credentials scheme",
+	},
+	"reveal_password": &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Do not display application icon to users",
+		Description: "Allow user to reveal password",
 	},
-	"user_name_template": &schema.Schema{
+	"shared_username": &schema.Schema{
 		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "Username template",
+		Optional:    true,
+		Description: "Shared username, required for the SHARED_USERNAME_AND_PASSWORD scheme.",
 	},
-	"user_name_template_type": &schema.Schema{
+	"shared_password": &schema.Schema{
 		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "Username template type",
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Shared password, required for the SHARED_USERNAME_AND_PASSWORD scheme.",
 	},
 }
 
@@ -169,9 +231,17 @@ func appRead(d *schema.ResourceData, name, status, signOn, label string, accy *o
 	d.Set("label", label)
 	d.Set("accessibility_self_service", accy.SelfService)
 	d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+	setVisibility(d, vis)
+}
+
+func setVisibility(d *schema.ResourceData, vis *okta.ApplicationVisibility) {
 	d.Set("auto_submit_toolbar", vis.AutoSubmitToolbar)
 	d.Set("hide_ios", vis.Hide.IOS)
 	d.Set("hide_web", vis.Hide.Web)
+	if vis.AppLinks != nil {
+		links, _ := json.Marshal(vis.AppLinks)
+		d.Set("app_links_json", normalizeDataJSON(string(links)))
+	}
 }
 
 func buildAppSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
@@ -184,34 +254,140 @@ func buildAppSchemaWithVisibility(appSchema map[string]*schema.Schema) map[strin
 }
 
 func buildSchemeCreds(d *schema.ResourceData) *okta.SchemeApplicationCredentials {
-	revealPass := d.Get("reveal_password").(bool)
+	creds := &okta.SchemeApplicationCredentials{
+		Scheme: d.Get("credentials_scheme").(string),
+	}
+	if revealPass, ok := d.GetOkExists("reveal_password"); ok {
+		reveal := revealPass.(bool)
+		creds.RevealPassword = &reveal
+	}
 
-	return &okta.SchemeApplicationCredentials{
-		RevealPassword: &revealPass,
-		Scheme:         d.Get("credentials_scheme").(string),
-		UserName:       d.Get("shared_username").(string),
-		Password: &okta.PasswordCredential{
+	if creds.Scheme == "SHARED_USERNAME_AND_PASSWORD" {
+		creds.UserName = d.Get("shared_username").(string)
+		creds.Password = &okta.PasswordCredential{
 			Value: d.Get("shared_password").(string),
-		},
+		}
+	}
+
+	if template, ok := d.GetOk("user_name_template"); ok {
+		creds.UserNameTemplate = &okta.ApplicationCredentialsUsernameTemplate{
+			Template: template.(string),
+			Type:     d.Get("user_name_template_type").(string),
+			Suffix:   d.Get("user_name_template_suffix").(string),
+		}
 	}
+
+	return creds
+}
+
+func setSchemeCreds(d *schema.ResourceData, creds *okta.SchemeApplicationCredentials) {
+	d.Set("credentials_scheme", creds.Scheme)
+	d.Set("reveal_password", creds.RevealPassword)
+	// We can sync shared username but not password from upstream
+	if creds.Scheme == "SHARED_USERNAME_AND_PASSWORD" {
+		d.Set("shared_username", creds.UserName)
+	} else {
+		d.Set("shared_username", "")
+	}
+	if creds.UserNameTemplate != nil {
+		d.Set("user_name_template", creds.UserNameTemplate.Template)
+		d.Set("user_name_template_type", creds.UserNameTemplate.Type)
+		d.Set("user_name_template_suffix", creds.UserNameTemplate.Suffix)
+	}
+}
+
+// Shared credentials only make sense for the scheme that shares them, Okta ignores them otherwise. Per user
+// credentials are dropped under that scheme, they would never converge. Interpolated values are only checked once known.
+func validateSchemeCreds(d *schema.ResourceDiff, v interface{}) error {
+	if !d.NewValueKnown("credentials_scheme") {
+		return nil
+	}
+	scheme := d.Get("credentials_scheme").(string)
+
+	if d.NewValueKnown("shared_username") && d.NewValueKnown("shared_password") {
+		username := d.Get("shared_username").(string)
+		password := d.Get("shared_password").(string)
+
+		if scheme == "SHARED_USERNAME_AND_PASSWORD" {
+			if username == "" || password == "" {
+				return fmt.Errorf("shared_username and shared_password are required by the %s scheme", scheme)
+			}
+		} else if username != "" || password != "" {
+			return fmt.Errorf("shared_username and shared_password are only supported by the SHARED_USERNAME_AND_PASSWORD scheme")
+		}
+	}
+
+	if scheme == "SHARED_USERNAME_AND_PASSWORD" && d.NewValueKnown("users") {
+		for _, raw := range d.Get("users").(*schema.Set).List() {
+			user := raw.(map[string]interface{})
+			if user["username"].(string) != "" || user["password"].(string) != "" {
+				return fmt.Errorf("username and password of user %s are not supported by the %s scheme, every user gets the shared credentials", user["id"], scheme)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Which of the per user credentials Okta accepts depends on the credentials scheme of the application. Shared
+// credentials apply to every user, external password sync and password only schemes get their password elsewhere.
+func buildAppUserCredentials(scheme, username, password string) *okta.AppUserCredentials {
+	switch scheme {
+	case "SHARED_USERNAME_AND_PASSWORD":
+		return nil
+	case "EXTERNAL_PASSWORD_SYNC", "EDIT_PASSWORD_ONLY":
+		password = ""
+	}
+
+	if username == "" && password == "" {
+		return nil
+	}
+
+	creds := &okta.AppUserCredentials{UserName: username}
+	if password != "" {
+		creds.Password = &okta.AppUserPasswordCredential{Value: password}
+	}
+
+	return creds
 }
 
 func buildAppSwaSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
-	s := buildAppSchema(appSchema)
+	s := buildAppSchemaWithVisibility(appSchema)
 	return buildSchema(baseappSwaSchema, s)
 }
 
+// Apps that did not manage their credentials scheme before keep what Okta has unless it is configured, a default would
+// reset the scheme of existing apps on their next apply.
+func buildAppSwaSchemaWithComputedScheme(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := buildAppSwaSchema(appSchema)
+	for _, key := range []string{"credentials_scheme", "reveal_password"} {
+		computed := *s[key]
+		computed.Default = nil
+		computed.Computed = true
+		s[key] = &computed
+	}
+
+	return s
+}
+
 func buildVisibility(d *schema.ResourceData) *okta.ApplicationVisibility {
 	autoSubmit := d.Get("auto_submit_toolbar").(bool)
 	hideMobile := d.Get("hide_ios").(bool)
 	hideWeb := d.Get("hide_web").(bool)
-	return &okta.ApplicationVisibility{
+	vis := &okta.ApplicationVisibility{
 		AutoSubmitToolbar: &autoSubmit,
 		Hide: &okta.ApplicationVisibilityHide{
 			IOS: &hideMobile,
 			Web: &hideWeb,
 		},
 	}
+	if links, ok := d.GetOk("app_links_json"); ok {
+		appLinks := map[string]interface{}{}
+		json.Unmarshal([]byte(links.(string)), &appLinks)
+		vis.AppLinks = appLinks
+	}
+
+	return vis
 }
 
 func fetchApp(d *schema.ResourceData, m interface{}, app okta.App) error {
@@ -320,6 +496,10 @@ func handleAppUsers(id string, d *schema.ResourceData, client *okta.Client) []fu
 		userIDList      []string
 	)
 
+	// Only password apps have a credentials scheme
+	scheme, _ := d.Get("credentials_scheme").(string)
+	oldUsers, _ := d.GetChange("users")
+
 	if set, ok := d.GetOk("users"); ok {
 		users = set.(*schema.Set).List()
 		userIDList = make([]string, len(users))
@@ -328,21 +508,26 @@ func handleAppUsers(id string, d *schema.ResourceData, client *okta.Client) []fu
 			userProfile := user.(map[string]interface{})
 			uID := userProfile["id"].(string)
 			userIDList[i] = uID
+			username := userProfile["username"].(string)
+			// Not required
+			password, _ := userProfile["password"].(string)
+			creds := buildAppUserCredentials(scheme, username, password)
 
 			if !containsAppUser(existingUsers, uID) {
-				username := userProfile["username"].(string)
-				// Not required
-				password, _ := userProfile["password"].(string)
-
 				asyncActionList = append(asyncActionList, func() error {
 					_, _, err := client.Application.AssignUserToApplication(id, okta.AppUser{
-						Id: uID,
-						Credentials: &okta.AppUserCredentials{
-							UserName: username,
-							Password: &okta.AppUserPasswordCredential{
-								Value: password,
-							},
-						},
+						Id:          uID,
+						Credentials: creds,
+					})
+
+					return err
+				})
+			} else if creds != nil && oldUsers != nil && !oldUsers.(*schema.Set).Contains(user) {
+				// The credentials of an assigned user changed
+				asyncActionList = append(asyncActionList, func() error {
+					_, _, err := client.Application.UpdateApplicationUser(id, uID, okta.AppUser{
+						Id:          uID,
+						Credentials: creds,
 					})
 
 					return err
@@ -406,13 +591,28 @@ func syncGroupsAndUsers(id string, d *schema.ResourceData, m interface{}) error
 	}
 
 	var flattenedUserList []interface{}
+	// Passwords are never returned, keep the configured ones so they do not cause a diff
+	passwords := map[string]interface{}{}
+	if set, ok := d.GetOk("users"); ok {
+		for _, user := range set.(*schema.Set).List() {
+			userProfile := user.(map[string]interface{})
+			passwords[userProfile["id"].(string)] = userProfile["password"]
+		}
+	}
 
 	for _, user := range userList {
 		if user.Scope == "USER" {
-			flattenedUserList = append(flattenedUserList, map[string]interface{}{
+			flatUser := map[string]interface{}{
 				"id":       user.Id,
-				"username": user.Credentials.UserName,
-			})
+				"username": "",
+			}
+			if user.Credentials != nil {
+				flatUser["username"] = user.Credentials.UserName
+			}
+			if password, ok := passwords[user.Id]; ok {
+				flatUser["password"] = password
+			}
+			flattenedUserList = append(flattenedUserList, flatUser)
 		}
 	}
 	flatMap := map[string]interface{}{}
@@ -479,25 +679,33 @@ func collectApps(reqExe *okta.RequestExecutor, filters *appFilters, results *sea
 }
 
 func filterApp(appList []*appID, filter *appFilters) []*appID {
-	// No filters, return it all!
-	if filter.Label == "" && filter.ID == "" && filter.LabelPrefix == "" {
-		return appList
-	}
-
 	filteredList := []*appID{}
 	for _, app := range appList {
-		if (filter.ID != "" && filter.ID == app.ID) || (filter.Label != "" && filter.Label == app.Label) {
-			filteredList = append(filteredList, app)
-		}
-
-		if filter.LabelPrefix != "" && strings.HasPrefix(app.Label, filter.LabelPrefix) {
+		if filter.matchesIdentity(app) && filter.matchesAttributes(app) {
 			filteredList = append(filteredList, app)
 		}
-
 	}
 	return filteredList
 }
 
+func (f *appFilters) matchesIdentity(app *appID) bool {
+	// No identity filters, everything matches
+	if f.Label == "" && f.ID == "" && f.LabelPrefix == "" {
+		return true
+	}
+
+	return (f.ID != "" && f.ID == app.ID) ||
+		(f.Label != "" && f.Label == app.Label) ||
+		(f.LabelPrefix != "" && strings.HasPrefix(app.Label, f.LabelPrefix))
+}
+
+func (f *appFilters) matchesAttributes(app *appID) bool {
+	return (f.LabelRegex == nil || f.LabelRegex.MatchString(app.Label)) &&
+		(f.Name == "" || f.Name == app.Name) &&
+		(f.SignOnMode == "" || f.SignOnMode == app.SignOnMode) &&
+		(f.Status == "" || f.Status == app.Status)
+}
+
 func (f *appFilters) shouldShortCircuit(appList []*appID) bool {
 	if f.LabelPrefix != "" {
 		return false
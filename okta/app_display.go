@@ -0,0 +1,198 @@
+package okta
+
+// Logos and notes apply to every app type but are not modelled by the SDK. Notes live in the settings of the app,
+// whose type varies per sign on mode, so they are written by updating the app as returned by Okta.
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+func (m *ApiSupplement) GetRawApp(id string) (map[string]interface{}, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := map[string]interface{}{}
+	resp, err := m.requestExecutor.Do(req, &app)
+	return app, resp, err
+}
+
+func (m *ApiSupplement) UpdateRawApp(id string, body map[string]interface{}) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s", id)
+	req, err := m.requestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+// The request executor only sends JSON, logos are uploaded as multipart forms.
+func (m *ApiSupplement) UploadAppLogo(id, path string) (*http.Response, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(part, file); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/api/v1/apps/%s/logo", m.baseURL, id)
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("SSWS %s", m.token))
+	req.Header.Add("User-Agent", "Terraform Okta Provider")
+	req.Header.Add("Accept", "application/json")
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	res, err := m.client.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(res.Body)
+		return res, fmt.Errorf("failed to upload logo %s to app %s, status: %s, %s", path, id, res.Status, msg)
+	}
+
+	return res, nil
+}
+
+// Logos are detected by content, the state holds the digest of the file rather than its path.
+func hashFile(val interface{}) string {
+	content, err := ioutil.ReadFile(val.(string))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func validateFileExists(val interface{}, key string) (warnings []string, errs []error) {
+	if info, err := os.Stat(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a readable file: %v", key, err))
+	} else if info.IsDir() {
+		errs = append(errs, fmt.Errorf("%s must be a file, %s is a directory", key, val))
+	}
+
+	return
+}
+
+// Pushes logo and notes after the app itself was created or updated.
+func handleAppNotesAndLogo(id string, d *schema.ResourceData, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+
+	// Unchanged logos hold the digest, the path is only available when it changes
+	if logo := d.Get("logo").(string); d.HasChange("logo") && logo != "" {
+		if _, err := client.UploadAppLogo(id, logo); err != nil {
+			return err
+		}
+	}
+
+	adminNote := d.Get("admin_note").(string)
+	enduserNote := d.Get("enduser_note").(string)
+	// Updating the app through its own type drops notes, they are set again whenever configured
+	if adminNote == "" && enduserNote == "" && !d.HasChange("admin_note") && !d.HasChange("enduser_note") {
+		return nil
+	}
+
+	app, _, err := client.GetRawApp(id)
+	if err != nil {
+		return err
+	}
+	settings, ok := app["settings"].(map[string]interface{})
+	if !ok {
+		settings = map[string]interface{}{}
+		app["settings"] = settings
+	}
+	settings["notes"] = map[string]interface{}{
+		"admin":   adminNote,
+		"enduser": enduserNote,
+	}
+	_, err = client.UpdateRawApp(id, app)
+
+	return err
+}
+
+// Fetches the app once, decoding it into its own type and into the plain map notes and the logo are read from.
+func fetchAppAndRaw(d *schema.ResourceData, m interface{}, app okta.App) (map[string]interface{}, error) {
+	client := getSupplementFromMetadata(m)
+	req, err := client.requestExecutor.NewRequest("GET", fmt.Sprintf("/api/v1/apps/%s", d.Id()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var body json.RawMessage
+	resp, err := client.requestExecutor.Do(req, &body)
+	// Like fetchApp, a missing app is not an error
+	if resp != nil && is404(resp.StatusCode) {
+		return map[string]interface{}{}, nil
+	}
+	if err != nil {
+		return nil, responseErr(resp, err)
+	}
+
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	return raw, json.Unmarshal(body, app)
+}
+
+func syncAppNotesAndLogo(d *schema.ResourceData, raw map[string]interface{}) {
+	adminNote, enduserNote := flattenAppNotes(raw)
+	d.Set("admin_note", adminNote)
+	d.Set("enduser_note", enduserNote)
+	d.Set("logo_url", flattenAppLogoUrl(raw))
+}
+
+func flattenAppNotes(app map[string]interface{}) (admin, enduser string) {
+	settings, _ := app["settings"].(map[string]interface{})
+	notes, _ := settings["notes"].(map[string]interface{})
+	admin, _ = notes["admin"].(string)
+	enduser, _ = notes["enduser"].(string)
+
+	return
+}
+
+// The logo link is a list, it is empty until a logo is uploaded
+func flattenAppLogoUrl(app map[string]interface{}) string {
+	links, _ := app["_links"].(map[string]interface{})
+	logos, _ := links["logo"].([]interface{})
+	if len(logos) == 0 {
+		return ""
+	}
+	logo, _ := logos[0].(map[string]interface{})
+	href, _ := logo["href"].(string)
+
+	return href
+}
@@ -0,0 +1,167 @@
+package okta
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+func TestHashFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	first := filepath.Join(dir, "first.png")
+	second := filepath.Join(dir, "second.png")
+	ioutil.WriteFile(first, []byte("logo"), 0644)
+	ioutil.WriteFile(second, []byte("logo"), 0644)
+
+	if hashFile(first) == "" || hashFile(first) != hashFile(second) {
+		t.Errorf("expected files with the same content to have the same digest")
+	}
+
+	ioutil.WriteFile(second, []byte("updated logo"), 0644)
+	if hashFile(first) == hashFile(second) {
+		t.Errorf("expected a changed file to have a different digest")
+	}
+
+	if _, errs := validateFileExists(dir, "logo"); len(errs) == 0 {
+		t.Errorf("expected directories to be rejected")
+	}
+	if _, errs := validateFileExists(filepath.Join(dir, "missing.png"), "logo"); len(errs) == 0 {
+		t.Errorf("expected missing files to be rejected")
+	}
+}
+
+func TestFlattenAppNotesAndLogo(t *testing.T) {
+	app := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"notes": map[string]interface{}{"admin": "admin note", "enduser": nil},
+		},
+		"_links": map[string]interface{}{
+			"logo": []interface{}{map[string]interface{}{"name": "medium", "href": "https://example.com/logo.png"}},
+		},
+	}
+
+	admin, enduser := flattenAppNotes(app)
+	if admin != "admin note" || enduser != "" {
+		t.Errorf("expected notes \"admin note\" and \"\", got %q and %q", admin, enduser)
+	}
+	if url := flattenAppLogoUrl(app); url != "https://example.com/logo.png" {
+		t.Errorf("expected logo URL https://example.com/logo.png, got %s", url)
+	}
+
+	admin, enduser = flattenAppNotes(map[string]interface{}{})
+	if admin != "" || enduser != "" || flattenAppLogoUrl(map[string]interface{}{}) != "" {
+		t.Errorf("expected apps without notes and logos to flatten to empty strings")
+	}
+}
+
+func TestUploadAppLogo(t *testing.T) {
+	var uploaded string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/apps/0oastandin/logo" || r.Header.Get("Authorization") != "SSWS standin" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		uploaded = string(content)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	file, err := ioutil.TempFile("", "logo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("logo")
+	file.Close()
+
+	client := &ApiSupplement{baseURL: server.URL, client: server.Client(), token: "standin"}
+	if _, err := client.UploadAppLogo("0oastandin", file.Name()); err != nil {
+		t.Fatalf("failed to upload logo: %v", err)
+	}
+	if uploaded != "logo" {
+		t.Errorf("expected the content of the file to be uploaded, got %q", uploaded)
+	}
+
+	if _, err := client.UploadAppLogo("0oamissing", file.Name()); err == nil {
+		t.Errorf("expected an error when the upload fails")
+	}
+}
+
+// Notes and the logo link come from the same response as the app, reading an app is a single request
+func TestFetchAppAndRaw(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/v1/apps/0oastandin" {
+			writeStandInError(w, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{
+  "id": "0oastandin",
+  "label": "Standin",
+  "signOnMode": "BOOKMARK",
+  "settings": {
+    "app": {"url": "https://example.com"},
+    "notes": {"admin": "admin note", "enduser": "end user note"}
+  },
+  "_links": {"logo": [{"name": "medium", "href": "https://example.com/logo.png"}]}
+}`)
+	}))
+	defer server.Close()
+
+	provider := standInProviders(server)["okta"].(*schema.Provider)
+	raw, err := config.NewRawConfig(map[string]interface{}{"org_name": "standin", "api_token": "standin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAppBookmark().Schema, map[string]interface{}{})
+	d.SetId("0oastandin")
+	app := okta.NewBookmarkApplication()
+	rawApp, err := fetchAppAndRaw(d, provider.Meta(), app)
+	if err != nil {
+		t.Fatalf("failed to fetch app: %v", err)
+	}
+	syncAppNotesAndLogo(d, rawApp)
+
+	if app.Label != "Standin" || app.Settings.App.Url != "https://example.com" {
+		t.Errorf("expected the app to be decoded, got %+v", app)
+	}
+	if d.Get("admin_note") != "admin note" || d.Get("enduser_note") != "end user note" {
+		t.Errorf("expected notes to be read, got %q and %q", d.Get("admin_note"), d.Get("enduser_note"))
+	}
+	if d.Get("logo_url") != "https://example.com/logo.png" {
+		t.Errorf("expected the logo link to be read, got %q", d.Get("logo_url"))
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+
+	d.SetId("0oamissing")
+	if _, err := fetchAppAndRaw(d, provider.Meta(), okta.NewBookmarkApplication()); err != nil {
+		t.Errorf("expected a missing app not to be an error, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package okta
+
+// Group push mappings are not supported by the SDK. A mapping pushes an Okta group to a provisioned app, either
+// creating the target group by name or linking an existing one.
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+type (
+	GroupPushMapping struct {
+		ErrorSummary    string `json:"errorSummary,omitempty"`
+		Id              string `json:"id,omitempty"`
+		SourceGroupId   string `json:"sourceGroupId,omitempty"`
+		Status          string `json:"status,omitempty"`
+		TargetGroupId   string `json:"targetGroupId,omitempty"`
+		TargetGroupName string `json:"targetGroupName,omitempty"`
+	}
+
+	GroupPushMappingStatus struct {
+		Status string `json:"status"`
+	}
+)
+
+func (m *ApiSupplement) CreateGroupPushMapping(appId string, body GroupPushMapping) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings", appId)
+	req, err := m.requestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapping := &GroupPushMapping{}
+	resp, err := m.requestExecutor.Do(req, mapping)
+	return mapping, resp, err
+}
+
+func (m *ApiSupplement) GetGroupPushMapping(appId, id string) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s", appId, id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapping := &GroupPushMapping{}
+	resp, err := m.requestExecutor.Do(req, mapping)
+	return mapping, resp, err
+}
+
+func (m *ApiSupplement) UpdateGroupPushMappingStatus(appId, id, status string) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s", appId, id)
+	req, err := m.requestExecutor.NewRequest("PATCH", url, GroupPushMappingStatus{Status: status})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapping := &GroupPushMapping{}
+	resp, err := m.requestExecutor.Do(req, mapping)
+	return mapping, resp, err
+}
+
+// Mappings must be inactive to be deleted
+func (m *ApiSupplement) DeleteGroupPushMapping(appId, id string, deleteTargetGroup bool) (*okta.Response, error) {
+	qp := url.Values{}
+	qp.Set("deleteTargetGroup", strconv.FormatBool(deleteTargetGroup))
+	uri := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s?%s", appId, id, qp.Encode())
+	req, err := m.requestExecutor.NewRequest("DELETE", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
@@ -0,0 +1,212 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Stand-in for the group push mappings of an app, it records which target groups were deleted.
+type groupPushStandIn struct {
+	sync.Mutex
+	appId         string
+	mappings      map[string]*GroupPushMapping
+	deletedGroups []string
+}
+
+func (s *groupPushStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	prefix := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings", s.appId)
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeStandInError(w, http.StatusNotFound)
+		return
+	}
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	if id == "" && r.Method == "POST" {
+		mapping := &GroupPushMapping{}
+		json.NewDecoder(r.Body).Decode(mapping)
+		mapping.Id = fmt.Sprintf("gPm%d", len(s.mappings)+1)
+		if mapping.TargetGroupId == "" {
+			mapping.TargetGroupId = "00g" + mapping.TargetGroupName
+		}
+		mapping.TargetGroupName = ""
+		s.mappings[mapping.Id] = mapping
+		json.NewEncoder(w).Encode(mapping)
+		return
+	}
+
+	mapping, ok := s.mappings[id]
+	if !ok {
+		writeStandInError(w, http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		json.NewEncoder(w).Encode(mapping)
+	case "PATCH":
+		body := GroupPushMappingStatus{}
+		json.NewDecoder(r.Body).Decode(&body)
+		mapping.Status = body.Status
+		json.NewEncoder(w).Encode(mapping)
+	case "DELETE":
+		if mapping.Status != "INACTIVE" {
+			writeStandInError(w, http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("deleteTargetGroup") == "true" {
+			s.deletedGroups = append(s.deletedGroups, mapping.TargetGroupId)
+		}
+		delete(s.mappings, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeStandInError(w, http.StatusNotFound)
+	}
+}
+
+func TestAppGroupPushStandIn(t *testing.T) {
+	standIn := &groupPushStandIn{appId: "0oastandin", mappings: map[string]*GroupPushMapping{}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	config := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+
+resource "okta_app_group_push" "by_name" {
+  app_id              = "0oastandin"
+  source_group_id     = "00gsource"
+  target_group_name   = "engineering"
+  delete_target_group = true
+}
+
+resource "okta_app_group_push" "by_id" {
+  app_id          = "0oastandin"
+  source_group_id = "00gsource"
+  target_group_id = "00gexisting"
+  status          = "%s"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: standInProviders(server),
+		CheckDestroy: func(s *terraform.State) error {
+			standIn.Lock()
+			defer standIn.Unlock()
+
+			if len(standIn.mappings) != 0 {
+				return fmt.Errorf("expected all mappings to be deleted, %d remain", len(standIn.mappings))
+			}
+			// Only the mapping that asked for it deletes its target group
+			if len(standIn.deletedGroups) != 1 || standIn.deletedGroups[0] != "00gengineering" {
+				return fmt.Errorf("expected only 00gengineering to be deleted, got %v", standIn.deletedGroups)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("okta_app_group_push.by_name", "target_group_id", "00gengineering"),
+					resource.TestCheckResourceAttr("okta_app_group_push.by_name", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("okta_app_group_push.by_id", "target_group_id", "00gexisting"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, "INACTIVE"),
+				Check:  resource.TestCheckResourceAttr("okta_app_group_push.by_id", "status", "INACTIVE"),
+			},
+			{
+				Config:            fmt.Sprintf(config, "INACTIVE"),
+				ResourceName:      "okta_app_group_push.by_id",
+				ImportState:       true,
+				ImportStateIdFunc: groupPushImportId("okta_app_group_push.by_id"),
+				ImportStateVerify: true,
+				// Only known to Terraform, Okta does not store it
+				ImportStateVerifyIgnore: []string{"delete_target_group"},
+			},
+		},
+	})
+}
+
+func TestAppGroupPushTargetRequired(t *testing.T) {
+	config := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+
+resource "okta_app_group_push" "test" {
+  app_id          = "0oastandin"
+  source_group_id = "00gsource"
+}
+`
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: standInProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("one of target_group_name or target_group_id is required"),
+			},
+		},
+	})
+}
+
+func groupPushImportId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["app_id"], rs.Primary.ID), nil
+	}
+}
+
+// Imported mappings only know their target group by ID, configuring it by name must not replace them
+func TestAppGroupPushImportedByName(t *testing.T) {
+	imported := &terraform.InstanceState{
+		ID: "gPm1",
+		Attributes: map[string]string{
+			"id":                  "gPm1",
+			"app_id":              "0oastandin",
+			"source_group_id":     "00gsource",
+			"target_group_id":     "00gengineering",
+			"status":              "ACTIVE",
+			"delete_target_group": "false",
+		},
+	}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"app_id":            "0oastandin",
+		"source_group_id":   "00gsource",
+		"target_group_name": "engineering",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := resourceAppGroupPush().Diff(imported, terraform.NewResourceConfig(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff, got %v", diff)
+	}
+}
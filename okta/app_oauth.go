@@ -0,0 +1,180 @@
+package okta
+
+// The SDK does not model JWKS, PKCE, refresh token or dashboard login settings of OAuth applications. These types
+// shadow the SDK ones, the fields declared here take precedence over the embedded ones when marshalling.
+
+import (
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
+type (
+	OAuthApplication struct {
+		*okta.OpenIdConnectApplication
+		Credentials *OAuthApplicationCredentials `json:"credentials,omitempty"`
+		Settings    *OAuthApplicationSettings    `json:"settings,omitempty"`
+	}
+
+	OAuthApplicationCredentials struct {
+		*okta.OAuthApplicationCredentials
+		OauthClient *OAuthClientCredentials `json:"oauthClient,omitempty"`
+	}
+
+	OAuthClientCredentials struct {
+		*okta.ApplicationCredentialsOAuthClient
+		PkceRequired *bool `json:"pkce_required,omitempty"`
+	}
+
+	OAuthApplicationSettings struct {
+		*okta.OpenIdConnectApplicationSettings
+		OauthClient *OAuthApplicationSettingsClient `json:"oauthClient,omitempty"`
+	}
+
+	OAuthApplicationSettingsClient struct {
+		*okta.OpenIdConnectApplicationSettingsClient
+		IdpInitiatedLogin *OAuthIdpInitiatedLogin `json:"idp_initiated_login,omitempty"`
+		Jwks              *JSONWebKeySet          `json:"jwks,omitempty"`
+		RefreshToken      *OAuthRefreshToken      `json:"refresh_token,omitempty"`
+	}
+
+	// Scopes requested when the OKTA mode launches the app from the dashboard
+	OAuthIdpInitiatedLogin struct {
+		DefaultScope []string `json:"default_scope,omitempty"`
+		Mode         string   `json:"mode"`
+	}
+
+	OAuthRefreshToken struct {
+		Leeway       int    `json:"leeway"`
+		RotationType string `json:"rotation_type,omitempty"`
+	}
+
+	// The plain text secret is only returned when it is created
+	OAuthClientSecret struct {
+		ClientSecret string `json:"client_secret,omitempty"`
+		Created      string `json:"created,omitempty"`
+		Id           string `json:"id,omitempty"`
+		SecretHash   string `json:"secret_hash,omitempty"`
+		Status       string `json:"status,omitempty"`
+	}
+)
+
+func newOAuthApplication() *OAuthApplication {
+	return &OAuthApplication{
+		OpenIdConnectApplication: okta.NewOpenIdConnectApplication(),
+		Credentials: &OAuthApplicationCredentials{
+			OAuthApplicationCredentials: okta.NewOAuthApplicationCredentials(),
+			OauthClient: &OAuthClientCredentials{
+				ApplicationCredentialsOAuthClient: okta.NewApplicationCredentialsOAuthClient(),
+			},
+		},
+		Settings: &OAuthApplicationSettings{
+			OpenIdConnectApplicationSettings: okta.NewOpenIdConnectApplicationSettings(),
+			OauthClient: &OAuthApplicationSettingsClient{
+				OpenIdConnectApplicationSettingsClient: okta.NewOpenIdConnectApplicationSettingsClient(),
+			},
+		},
+	}
+}
+
+func (m *ApiSupplement) ListAppOAuthSecrets(appId string) ([]*OAuthClientSecret, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appId)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var secrets []*OAuthClientSecret
+	resp, err := m.requestExecutor.Do(req, &secrets)
+	return secrets, resp, err
+}
+
+// Okta generates the secret, it is ACTIVE as soon as it is created
+func (m *ApiSupplement) CreateAppOAuthSecret(appId string) (*OAuthClientSecret, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets", appId)
+	req, err := m.requestExecutor.NewRequest("POST", url, map[string]string{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := &OAuthClientSecret{}
+	resp, err := m.requestExecutor.Do(req, secret)
+	return secret, resp, err
+}
+
+func (m *ApiSupplement) DeactivateAppOAuthSecret(appId, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s/lifecycle/deactivate", appId, id)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+// Only INACTIVE secrets can be deleted
+func (m *ApiSupplement) DeleteAppOAuthSecret(appId, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/secrets/%s", appId, id)
+	req, err := m.requestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+type AppGrant struct {
+	ClientId string `json:"clientId,omitempty"`
+	Id       string `json:"id,omitempty"`
+	Issuer   string `json:"issuer,omitempty"`
+	ScopeId  string `json:"scopeId,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// Follows the next links, every grant of the app is returned
+func (m *ApiSupplement) ListAppGrants(appId string) ([]*AppGrant, *okta.Response, error) {
+	var grants []*AppGrant
+	qp := &query.Params{}
+
+	for {
+		url := fmt.Sprintf("/api/v1/apps/%s/grants%s", appId, qp.String())
+		req, err := m.requestExecutor.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []*AppGrant
+		resp, err := m.requestExecutor.Do(req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+		grants = append(grants, page...)
+
+		if qp.After = getAfterParam(resp); qp.After == "" {
+			return grants, resp, nil
+		}
+	}
+}
+
+func (m *ApiSupplement) GrantAppScope(appId string, body AppGrant) (*AppGrant, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/grants", appId)
+	req, err := m.requestExecutor.NewRequest("POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grant := &AppGrant{}
+	resp, err := m.requestExecutor.Do(req, grant)
+	return grant, resp, err
+}
+
+func (m *ApiSupplement) RevokeAppGrant(appId, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/grants/%s", appId, id)
+	req, err := m.requestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
@@ -0,0 +1,233 @@
+package okta
+
+// Provisioning connections and features are not supported by the SDK. The provider exposes features the way the app
+// features array names them, these are mapped to the capabilities of Okta's USER_PROVISIONING and
+// INBOUND_PROVISIONING features.
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+const (
+	userProvisioning    = "USER_PROVISIONING"
+	inboundProvisioning = "INBOUND_PROVISIONING"
+	capabilityEnabled   = "ENABLED"
+	capabilityDisabled  = "DISABLED"
+)
+
+var provisioningFeatures = []string{
+	"PUSH_NEW_USERS",
+	"PUSH_PROFILE_UPDATES",
+	"PUSH_USER_DEACTIVATION",
+	"PUSH_PASSWORD_UPDATES",
+	"IMPORT_NEW_USERS",
+}
+
+type (
+	AppConnection struct {
+		AuthScheme string                `json:"authScheme,omitempty"`
+		BaseUrl    string                `json:"baseUrl,omitempty"`
+		Profile    *AppConnectionProfile `json:"profile,omitempty"`
+		Status     string                `json:"status,omitempty"`
+	}
+
+	// The token is write only, it is never returned
+	AppConnectionProfile struct {
+		AuthScheme string `json:"authScheme"`
+		Token      string `json:"token,omitempty"`
+	}
+
+	AppFeature struct {
+		Capabilities *AppFeatureCapabilities `json:"capabilities,omitempty"`
+		Name         string                  `json:"name,omitempty"`
+		Status       string                  `json:"status,omitempty"`
+	}
+
+	AppFeatureCapabilities struct {
+		Create         *ProvisioningCreate `json:"create,omitempty"`
+		ImportSettings *ImportSettings     `json:"importSettings,omitempty"`
+		Update         *ProvisioningUpdate `json:"update,omitempty"`
+	}
+
+	ProvisioningCreate struct {
+		LifecycleCreate *CapabilityStatus `json:"lifecycleCreate,omitempty"`
+	}
+
+	ProvisioningUpdate struct {
+		LifecycleDeactivate *CapabilityStatus   `json:"lifecycleDeactivate,omitempty"`
+		Password            *PasswordCapability `json:"password,omitempty"`
+		Profile             *CapabilityStatus   `json:"profile,omitempty"`
+	}
+
+	CapabilityStatus struct {
+		Status string `json:"status"`
+	}
+
+	PasswordCapability struct {
+		Change string `json:"change,omitempty"`
+		Seed   string `json:"seed,omitempty"`
+		Status string `json:"status"`
+	}
+
+	ImportSettings struct {
+		Schedule *ImportSchedule `json:"schedule,omitempty"`
+	}
+
+	ImportSchedule struct {
+		FullImport        *ImportScheduleExpression `json:"fullImport,omitempty"`
+		IncrementalImport *ImportScheduleExpression `json:"incrementalImport,omitempty"`
+		Status            string                    `json:"status"`
+	}
+
+	ImportScheduleExpression struct {
+		Expression string `json:"expression"`
+		Timezone   string `json:"timezone,omitempty"`
+	}
+)
+
+func capabilityStatus(enabled bool) *CapabilityStatus {
+	if enabled {
+		return &CapabilityStatus{Status: capabilityEnabled}
+	}
+
+	return &CapabilityStatus{Status: capabilityDisabled}
+}
+
+func isCapabilityEnabled(status *CapabilityStatus) bool {
+	return status != nil && status.Status == capabilityEnabled
+}
+
+// Capabilities of USER_PROVISIONING matching the given features, the ones not listed are disabled.
+func buildUserProvisioningCapabilities(features []string, passwordSeed string) *AppFeatureCapabilities {
+	password := &PasswordCapability{Status: capabilityDisabled}
+	if contains(features, "PUSH_PASSWORD_UPDATES") {
+		password = &PasswordCapability{
+			Change: "CHANGE",
+			Seed:   passwordSeed,
+			Status: capabilityEnabled,
+		}
+	}
+
+	return &AppFeatureCapabilities{
+		Create: &ProvisioningCreate{
+			LifecycleCreate: capabilityStatus(contains(features, "PUSH_NEW_USERS")),
+		},
+		Update: &ProvisioningUpdate{
+			LifecycleDeactivate: capabilityStatus(contains(features, "PUSH_USER_DEACTIVATION")),
+			Password:            password,
+			Profile:             capabilityStatus(contains(features, "PUSH_PROFILE_UPDATES")),
+		},
+	}
+}
+
+func flattenProvisioningFeatures(userFeature, inboundFeature *AppFeature) []string {
+	var features []string
+	if userFeature != nil && userFeature.Capabilities != nil {
+		if create := userFeature.Capabilities.Create; create != nil && isCapabilityEnabled(create.LifecycleCreate) {
+			features = append(features, "PUSH_NEW_USERS")
+		}
+		if update := userFeature.Capabilities.Update; update != nil {
+			if isCapabilityEnabled(update.Profile) {
+				features = append(features, "PUSH_PROFILE_UPDATES")
+			}
+			if isCapabilityEnabled(update.LifecycleDeactivate) {
+				features = append(features, "PUSH_USER_DEACTIVATION")
+			}
+			if update.Password != nil && update.Password.Status == capabilityEnabled {
+				features = append(features, "PUSH_PASSWORD_UPDATES")
+			}
+		}
+	}
+	if inboundFeature != nil && inboundFeature.Status == capabilityEnabled {
+		features = append(features, "IMPORT_NEW_USERS")
+	}
+
+	return features
+}
+
+func (m *ApiSupplement) GetAppConnection(appId string) (*AppConnection, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default", appId)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connection := &AppConnection{}
+	resp, err := m.requestExecutor.Do(req, connection)
+	return connection, resp, err
+}
+
+func (m *ApiSupplement) UpdateAppConnection(appId string, body AppConnection, activate bool) (*AppConnection, *okta.Response, error) {
+	qp := url.Values{}
+	qp.Set("activate", strconv.FormatBool(activate))
+	uri := fmt.Sprintf("/api/v1/apps/%s/connections/default?%s", appId, qp.Encode())
+	req, err := m.requestExecutor.NewRequest("POST", uri, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connection := &AppConnection{}
+	resp, err := m.requestExecutor.Do(req, connection)
+	return connection, resp, err
+}
+
+func (m *ApiSupplement) ActivateAppConnection(appId string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default/lifecycle/activate", appId)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+func (m *ApiSupplement) DeactivateAppConnection(appId string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default/lifecycle/deactivate", appId)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+func (m *ApiSupplement) GetAppFeature(appId, name string) (*AppFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appId, name)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feature := &AppFeature{}
+	resp, err := m.requestExecutor.Do(req, feature)
+	return feature, resp, err
+}
+
+func (m *ApiSupplement) UpdateAppFeature(appId, name string, body *AppFeatureCapabilities) (*AppFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appId, name)
+	req, err := m.requestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feature := &AppFeature{}
+	resp, err := m.requestExecutor.Do(req, feature)
+	return feature, resp, err
+}
+
+// Lifecycle is either enable or disable
+func (m *ApiSupplement) UpdateAppFeatureLifecycle(appId, name, lifecycle string) (*AppFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s/lifecycle/%s", appId, name, lifecycle)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feature := &AppFeature{}
+	resp, err := m.requestExecutor.Do(req, feature)
+	return feature, resp, err
+}
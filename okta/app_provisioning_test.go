@@ -0,0 +1,244 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestProvisioningFeatures(t *testing.T) {
+	tests := []struct {
+		features []string
+	}{
+		{nil},
+		{[]string{"PUSH_NEW_USERS"}},
+		{[]string{"PUSH_PROFILE_UPDATES", "PUSH_USER_DEACTIVATION"}},
+		{[]string{"PUSH_NEW_USERS", "PUSH_PROFILE_UPDATES", "PUSH_USER_DEACTIVATION", "PUSH_PASSWORD_UPDATES"}},
+	}
+
+	for _, test := range tests {
+		feature := &AppFeature{Capabilities: buildUserProvisioningCapabilities(test.features, "RANDOM")}
+		actual := flattenProvisioningFeatures(feature, nil)
+		if !reflect.DeepEqual(actual, test.features) {
+			t.Errorf("expected features %v, got %v", test.features, actual)
+		}
+	}
+
+	password := buildUserProvisioningCapabilities([]string{"PUSH_PASSWORD_UPDATES"}, "RANDOM").Update.Password
+	if password.Seed != "RANDOM" || password.Status != capabilityEnabled {
+		t.Errorf("expected an enabled password capability seeded with RANDOM, got %+v", password)
+	}
+
+	inbound := &AppFeature{Status: capabilityEnabled}
+	if actual := flattenProvisioningFeatures(nil, inbound); !reflect.DeepEqual(actual, []string{"IMPORT_NEW_USERS"}) {
+		t.Errorf("expected IMPORT_NEW_USERS, got %v", actual)
+	}
+}
+
+// Stand-in for the provisioning endpoints of a SCIM app, it keeps connection and features in memory.
+type provisioningStandIn struct {
+	sync.Mutex
+	appId      string
+	connection AppConnection
+	token      string
+	features   map[string]*AppFeature
+}
+
+func newProvisioningStandIn(appId string) *provisioningStandIn {
+	return &provisioningStandIn{
+		appId:      appId,
+		connection: AppConnection{AuthScheme: "TOKEN", Status: capabilityDisabled},
+		features: map[string]*AppFeature{
+			userProvisioning: &AppFeature{
+				Capabilities: buildUserProvisioningCapabilities(nil, ""),
+				Name:         userProvisioning,
+				Status:       capabilityDisabled,
+			},
+			inboundProvisioning: &AppFeature{
+				Capabilities: &AppFeatureCapabilities{
+					ImportSettings: &ImportSettings{Schedule: &ImportSchedule{Status: capabilityDisabled}},
+				},
+				Name:   inboundProvisioning,
+				Status: capabilityDisabled,
+			},
+		},
+	}
+}
+
+func (s *provisioningStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	prefix := fmt.Sprintf("/api/v1/apps/%s/", s.appId)
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeStandInError(w, http.StatusNotFound)
+		return
+	}
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	switch {
+	case len(path) == 2 && path[0] == "connections" && r.Method == "GET":
+		json.NewEncoder(w).Encode(s.connection)
+	case len(path) == 2 && path[0] == "connections" && r.Method == "POST":
+		body := AppConnection{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.connection.BaseUrl = body.BaseUrl
+		if body.Profile != nil && body.Profile.Token != "" {
+			s.token = body.Profile.Token
+		}
+		if r.URL.Query().Get("activate") == "true" {
+			s.connection.Status = capabilityEnabled
+		}
+		json.NewEncoder(w).Encode(s.connection)
+	case len(path) == 4 && path[0] == "connections" && r.Method == "POST":
+		s.connection.Status = map[string]string{"activate": capabilityEnabled, "deactivate": capabilityDisabled}[path[3]]
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) >= 2 && path[0] == "features":
+		feature, ok := s.features[path[1]]
+		if !ok {
+			writeStandInError(w, http.StatusNotFound)
+			return
+		}
+		if r.Method != "GET" && s.connection.Status != capabilityEnabled {
+			writeStandInError(w, http.StatusBadRequest)
+			return
+		}
+		if len(path) == 4 {
+			feature.Status = map[string]string{"enable": capabilityEnabled, "disable": capabilityDisabled}[path[3]]
+		} else if r.Method == "PUT" {
+			body := &AppFeatureCapabilities{}
+			json.NewDecoder(r.Body).Decode(body)
+			if body.Create != nil {
+				feature.Capabilities.Create = body.Create
+			}
+			if body.Update != nil {
+				feature.Capabilities.Update = body.Update
+			}
+			if body.ImportSettings != nil {
+				feature.Capabilities.ImportSettings = body.ImportSettings
+			}
+		}
+		json.NewEncoder(w).Encode(feature)
+	default:
+		writeStandInError(w, http.StatusNotFound)
+	}
+}
+
+func TestAppProvisioningStandIn(t *testing.T) {
+	standIn := newProvisioningStandIn("0oastandin")
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	providerConfig := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+`
+	resourceName := fmt.Sprintf("%s.test", appProvisioning)
+	config := providerConfig + `
+resource "okta_app_provisioning" "test" {
+  app_id   = "0oastandin"
+  base_url = "https://scim.example.com/scim/v2"
+  token    = "first-token"
+  features = ["PUSH_NEW_USERS", "PUSH_PROFILE_UPDATES", "PUSH_USER_DEACTIVATION", "IMPORT_NEW_USERS"]
+
+  import_schedule {
+    full_import        = "0 0 * * 0"
+    incremental_import = "0 * * * *"
+    timezone           = "America/New_York"
+  }
+}
+`
+	updatedConfig := providerConfig + `
+resource "okta_app_provisioning" "test" {
+  app_id        = "0oastandin"
+  base_url      = "https://scim.example.com/scim/v2"
+  token         = "second-token"
+  features      = ["PUSH_NEW_USERS", "PUSH_PASSWORD_UPDATES"]
+  password_seed = "RANDOM"
+}
+`
+	disabledConfig := providerConfig + `
+resource "okta_app_provisioning" "test" {
+  app_id   = "0oastandin"
+  base_url = "https://scim.example.com/scim/v2"
+  token    = "second-token"
+  enabled  = false
+}
+`
+	checkStandIn := func(token string, enabled bool, features ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			standIn.Lock()
+			defer standIn.Unlock()
+
+			if standIn.token != token {
+				return fmt.Errorf("expected stand-in to have received token %s, got %s", token, standIn.token)
+			}
+			if (standIn.connection.Status == capabilityEnabled) != enabled {
+				return fmt.Errorf("expected connection enabled to be %v, got status %s", enabled, standIn.connection.Status)
+			}
+			actual := flattenProvisioningFeatures(standIn.features[userProvisioning], standIn.features[inboundProvisioning])
+			sort.Strings(actual)
+			sort.Strings(features)
+			if !reflect.DeepEqual(actual, features) {
+				return fmt.Errorf("expected stand-in features %v, got %v", features, actual)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    standInProviders(server),
+		CheckDestroy: checkStandIn("second-token", false),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkStandIn("first-token", true, "IMPORT_NEW_USERS", "PUSH_NEW_USERS", "PUSH_PROFILE_UPDATES", "PUSH_USER_DEACTIVATION"),
+					resource.TestCheckResourceAttr(resourceName, "id", "0oastandin"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.0.full_import", "0 0 * * 0"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.0.timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(resourceName, "token", hashSecret("first-token")),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkStandIn("second-token", true, "PUSH_NEW_USERS", "PUSH_PASSWORD_UPDATES"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "password_seed", "RANDOM"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.#", "0"),
+				),
+			},
+			{
+				// The provider block is taken from the step config
+				Config:                  updatedConfig,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			{
+				// The features are turned off before the connection is, Okta would keep them enabled otherwise
+				Config: disabledConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkStandIn("second-token", false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package okta
+
+// The SDK does not model single logout, assertion encryption, multiple ACS endpoints, inline hooks or group attribute
+// statements of SAML applications. These types shadow the SDK ones, the fields declared here take precedence when
+// marshalling.
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+type (
+	SamlApplication struct {
+		*okta.SamlApplication
+		Settings *SamlApplicationSettings `json:"settings,omitempty"`
+	}
+
+	SamlApplicationSettings struct {
+		*okta.SamlApplicationSettings
+		SignOn *SamlApplicationSettingsSignOn `json:"signOn,omitempty"`
+	}
+
+	SamlApplicationSettingsSignOn struct {
+		*okta.SamlApplicationSettingsSignOn
+		AcsEndpoints              []*AcsEndpoint        `json:"acsEndpoints,omitempty"`
+		AllowMultipleAcsEndpoints *bool                 `json:"allowMultipleAcsEndpoints,omitempty"`
+		AssertionEncryption       *AssertionEncryption  `json:"assertionEncryption,omitempty"`
+		AttributeStatements       []*AttributeStatement `json:"attributeStatements,omitempty"`
+		InlineHooks               []*SignOnInlineHook   `json:"inlineHooks,omitempty"`
+		Slo                       *SingleLogout         `json:"slo,omitempty"`
+		SpCertificate             *SpCertificate        `json:"spCertificate,omitempty"`
+	}
+
+	AcsEndpoint struct {
+		Index int    `json:"index"`
+		Url   string `json:"url"`
+	}
+
+	AssertionEncryption struct {
+		Enabled               *bool    `json:"enabled,omitempty"`
+		EncryptionAlgorithm   string   `json:"encryptionAlgorithm,omitempty"`
+		KeyTransportAlgorithm string   `json:"keyTransportAlgorithm,omitempty"`
+		X5c                   []string `json:"x5c,omitempty"`
+	}
+
+	// Group statements select group names with a filter, expression statements evaluate their values
+	AttributeStatement struct {
+		FilterType  string   `json:"filterType,omitempty"`
+		FilterValue string   `json:"filterValue,omitempty"`
+		Name        string   `json:"name,omitempty"`
+		Namespace   string   `json:"namespace,omitempty"`
+		Type        string   `json:"type,omitempty"`
+		Values      []string `json:"values,omitempty"`
+	}
+
+	SignOnInlineHook struct {
+		Id string `json:"id"`
+	}
+
+	SingleLogout struct {
+		Enabled   *bool  `json:"enabled,omitempty"`
+		Issuer    string `json:"issuer,omitempty"`
+		LogoutUrl string `json:"logoutUrl,omitempty"`
+	}
+
+	SpCertificate struct {
+		X5c []string `json:"x5c,omitempty"`
+	}
+)
+
+func newSamlApplication() *SamlApplication {
+	app := okta.NewSamlApplication()
+	app.Settings = okta.NewSamlApplicationSettings()
+	app.Settings.SignOn = &okta.SamlApplicationSettingsSignOn{}
+
+	return &SamlApplication{
+		SamlApplication: app,
+		Settings: &SamlApplicationSettings{
+			SamlApplicationSettings: app.Settings,
+			SignOn: &SamlApplicationSettingsSignOn{
+				SamlApplicationSettingsSignOn: app.Settings.SignOn,
+			},
+		},
+	}
+}
+
+// Accepts PEM encoded certificates as well as base64 encoded DER, the format Okta uses in x5c arrays.
+func parseCertificate(cert string) (*x509.Certificate, error) {
+	cert = strings.TrimSpace(cert)
+	if block, _ := pem.Decode([]byte(cert)); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected a CERTIFICATE PEM block, got %s", block.Type)
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(cert), ""))
+	if err != nil {
+		return nil, errors.New("certificate is neither PEM nor base64 encoded DER")
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+func validateCertificate(val interface{}, key string) (warnings []string, errs []error) {
+	if _, err := parseCertificate(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s is not a valid X.509 certificate: %v", key, err))
+	}
+
+	return
+}
+
+// Stores certificates the way Okta returns them so PEM input does not cause a diff.
+func normalizeCertificate(val interface{}) string {
+	cert, err := parseCertificate(val.(string))
+	if err != nil {
+		return val.(string)
+	}
+
+	return base64.StdEncoding.EncodeToString(cert.Raw)
+}
+
+func certificateToX5c(val string) []string {
+	if val == "" {
+		return nil
+	}
+
+	return []string{normalizeCertificate(val)}
+}
+
+// Colon separated upper case hex, the format openssl and most service providers display.
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(parts, ":")
+}
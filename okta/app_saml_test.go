@@ -0,0 +1,80 @@
+package okta
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func generateTestCertificate(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return der
+}
+
+func TestParseCertificate(t *testing.T) {
+	der := generateTestCertificate(t)
+	encoded := base64.StdEncoding.EncodeToString(der)
+	pemEncoded := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("nope")}))
+
+	tests := []struct {
+		name    string
+		cert    string
+		wantErr bool
+	}{
+		{"pem", pemEncoded, false},
+		{"der", encoded, false},
+		{"wrapped der", encoded[:64] + "\n" + encoded[64:], false},
+		{"private key", keyPem, true},
+		{"garbage", "not a certificate", true},
+		{"truncated der", encoded[:64], true},
+	}
+
+	for _, tt := range tests {
+		_, err := parseCertificate(tt.cert)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+
+	if normalizeCertificate(pemEncoded) != encoded {
+		t.Error("expected PEM certificate to be normalized to base64 DER")
+	}
+}
+
+func TestCertificateFingerprint(t *testing.T) {
+	der := generateTestCertificate(t)
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fingerprint := certificateFingerprint(cert)
+	if len(fingerprint) != 95 {
+		t.Errorf("expected 32 colon separated bytes, got %s", fingerprint)
+	}
+	if fingerprint != strings.ToUpper(fingerprint) {
+		t.Errorf("expected upper case hex, got %s", fingerprint)
+	}
+}
@@ -0,0 +1,34 @@
+package okta
+
+// The SDK models the credentials of SWA and three field applications without a scheme, even though Okta supports the
+// same credential schemes as auto login applications. These types shadow the SDK ones.
+
+import (
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+type (
+	SwaApplication struct {
+		*okta.SwaApplication
+		Credentials *okta.SchemeApplicationCredentials `json:"credentials,omitempty"`
+	}
+
+	SwaThreeFieldApplication struct {
+		*okta.SwaThreeFieldApplication
+		Credentials *okta.SchemeApplicationCredentials `json:"credentials,omitempty"`
+	}
+)
+
+func newSwaApplication() *SwaApplication {
+	return &SwaApplication{
+		SwaApplication: okta.NewSwaApplication(),
+		Credentials:    &okta.SchemeApplicationCredentials{},
+	}
+}
+
+func newSwaThreeFieldApplication() *SwaThreeFieldApplication {
+	return &SwaThreeFieldApplication{
+		SwaThreeFieldApplication: okta.NewSwaThreeFieldApplication(),
+		Credentials:              &okta.SchemeApplicationCredentials{},
+	}
+}
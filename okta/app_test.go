@@ -2,7 +2,13 @@ package okta
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func deleteTestApps(client *testClient) error {
@@ -37,3 +43,130 @@ func deleteTestApps(client *testClient) error {
 
 	return nil
 }
+
+func TestBuildAppUserCredentials(t *testing.T) {
+	tests := []struct {
+		scheme           string
+		expectedUsername string
+		expectedPassword string
+		expectNil        bool
+	}{
+		{"EDIT_USERNAME_AND_PASSWORD", "user", "pass", false},
+		{"ADMIN_SETS_CREDENTIALS", "user", "pass", false},
+		{"EDIT_PASSWORD_ONLY", "user", "", false},
+		{"EXTERNAL_PASSWORD_SYNC", "user", "", false},
+		{"SHARED_USERNAME_AND_PASSWORD", "", "", true},
+	}
+
+	for _, tt := range tests {
+		creds := buildAppUserCredentials(tt.scheme, "user", "pass")
+		if tt.expectNil {
+			if creds != nil {
+				t.Errorf("%s: expected no credentials, got %+v", tt.scheme, creds)
+			}
+			continue
+		}
+		if creds == nil || creds.UserName != tt.expectedUsername {
+			t.Errorf("%s: expected username %s, got %+v", tt.scheme, tt.expectedUsername, creds)
+			continue
+		}
+		password := ""
+		if creds.Password != nil {
+			password = creds.Password.Value
+		}
+		if password != tt.expectedPassword {
+			t.Errorf("%s: expected password %q, got %q", tt.scheme, tt.expectedPassword, password)
+		}
+	}
+
+	if buildAppUserCredentials("ADMIN_SETS_CREDENTIALS", "", "") != nil {
+		t.Error("expected no credentials when none are configured")
+	}
+}
+
+func TestFilterApp(t *testing.T) {
+	appList := []*appID{
+		{ID: "0oa1", Label: "testAcc_web", Name: "oidc_client", SignOnMode: "OPENID_CONNECT", Status: "ACTIVE"},
+		{ID: "0oa2", Label: "testAcc_saml", Name: "testacc_saml", SignOnMode: "SAML_2_0", Status: "INACTIVE"},
+		{ID: "0oa3", Label: "Payroll", Name: "bookmark", SignOnMode: "BOOKMARK", Status: "ACTIVE"},
+	}
+	tests := []struct {
+		name     string
+		filters  *appFilters
+		expected []string
+	}{
+		{"no filters", &appFilters{}, []string{"0oa1", "0oa2", "0oa3"}},
+		{"label prefix", &appFilters{LabelPrefix: "testAcc_"}, []string{"0oa1", "0oa2"}},
+		{"label regex", &appFilters{LabelRegex: regexp.MustCompile("^testAcc_(web|api)$")}, []string{"0oa1"}},
+		{"sign on mode", &appFilters{SignOnMode: "SAML_2_0"}, []string{"0oa2"}},
+		{"status", &appFilters{Status: "ACTIVE"}, []string{"0oa1", "0oa3"}},
+		{"name and status", &appFilters{Name: "oidc_client", Status: "INACTIVE"}, []string{}},
+		{"identity and attributes", &appFilters{LabelPrefix: "testAcc_", Status: "ACTIVE"}, []string{"0oa1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids := []string{}
+			for _, app := range filterApp(appList, test.filters) {
+				ids = append(ids, app.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected %v, got %v", test.expected, ids)
+			}
+		})
+	}
+}
+
+func TestValidateSchemeCreds(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	config := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+
+resource "okta_group" "group" {
+  name = "standin"
+}
+
+resource "okta_app_basic_auth" "test" {
+  label              = "standin"
+  url                = "https://example.com/login.html"
+  auth_url           = "https://example.com/auth.html"
+  credentials_scheme = "SHARED_USERNAME_AND_PASSWORD"
+  shared_username    = "sharedusername"
+  shared_password    = "%s"
+  %s
+}
+`
+	perUser := `
+  users {
+    id       = "00ustandin"
+    username = "someone"
+  }
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: standInProviders(server),
+		Steps: []resource.TestStep{
+			{
+				// Only known at apply time, it must not be mistaken for a missing password
+				Config:             fmt.Sprintf(config, "${okta_group.group.id}", ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      fmt.Sprintf(config, "", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("shared_username and shared_password are required"),
+			},
+			{
+				Config:      fmt.Sprintf(config, "sharedpassword", perUser),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("username and password of user 00ustandin are not supported"),
+			},
+		},
+	})
+}
@@ -11,10 +11,17 @@ type AuthorizationServerScope struct {
 	Name            string `json:"name,omitempty"`
 	Id              string `json:"id,omitempty"`
 	Description     string `json:"description,omitempty"`
+	DisplayName     string `json:"displayName,omitempty"`
 	Consent         string `json:"consent,omitempty"`
 	MetadataPublish string `json:"metadataPublish,omitempty"`
+	// Not omitted so a scope can stop being a default scope
+	Default bool `json:"default"`
+	System  bool `json:"system,omitempty"`
 }
 
+// Scopes every auth server is created with. They cannot be renamed or deleted, only their settings can be changed.
+var reservedScopes = []string{"address", "device_sso", "email", "offline_access", "openid", "phone", "profile"}
+
 func (m *ApiSupplement) DeleteAuthorizationServerScope(authServerId, id string) (*okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/authorizationServers/%s/scopes/%s", authServerId, id)
 	req, err := m.requestExecutor.NewRequest("DELETE", url, nil)
@@ -3,6 +3,7 @@ package okta
 // Not all APIs are supported by okta-sdk-golang, this is one
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -26,6 +27,57 @@ type AuthServerCredentials struct {
 	Signing *okta.ApplicationCredentialsSigning `json:"signing,omitempty"`
 }
 
+type AuthServerKey struct {
+	Alg    string `json:"alg,omitempty"`
+	Kid    string `json:"kid,omitempty"`
+	Kty    string `json:"kty,omitempty"`
+	Status string `json:"status,omitempty"`
+	Use    string `json:"use,omitempty"`
+	X5t    string `json:"x5t#S256,omitempty"`
+}
+
+// OIDC discovery document, only the fields we expose are decoded
+type AuthServerMetadata struct {
+	AuthorizationEndpoint         string   `json:"authorization_endpoint,omitempty"`
+	ClaimsSupported               []string `json:"claims_supported,omitempty"`
+	EndSessionEndpoint            string   `json:"end_session_endpoint,omitempty"`
+	GrantTypesSupported           []string `json:"grant_types_supported,omitempty"`
+	IntrospectionEndpoint         string   `json:"introspection_endpoint,omitempty"`
+	Issuer                        string   `json:"issuer,omitempty"`
+	JwksUri                       string   `json:"jwks_uri,omitempty"`
+	RegistrationEndpoint          string   `json:"registration_endpoint,omitempty"`
+	ResponseTypesSupported        []string `json:"response_types_supported,omitempty"`
+	RevocationEndpoint            string   `json:"revocation_endpoint,omitempty"`
+	ScopesSupported               []string `json:"scopes_supported,omitempty"`
+	SubjectTypesSupported         []string `json:"subject_types_supported,omitempty"`
+	TokenEndpoint                 string   `json:"token_endpoint,omitempty"`
+	TokenEndpointAuthMethods      []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+	UserinfoEndpoint              string   `json:"userinfo_endpoint,omitempty"`
+	IdTokenSigningAlgValues       []string `json:"id_token_signing_alg_values_supported,omitempty"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported,omitempty"`
+}
+
+// RSA keys have e and n, EC keys have crv, x and y
+type JSONWebKey struct {
+	Alg string `json:"alg,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	E   string `json:"e,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Kty string `json:"kty,omitempty"`
+	N   string `json:"n,omitempty"`
+	Use string `json:"use,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey `json:"keys"`
+}
+
+type keyRotation struct {
+	Use string `json:"use"`
+}
+
 func (m *ApiSupplement) DeleteAuthorizationServer(id string) (*okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/authorizationServers/%s", id)
 	req, err := m.requestExecutor.NewRequest("DELETE", url, nil)
@@ -105,6 +157,31 @@ func (m *ApiSupplement) DeactivateAuthorizationServer(id string) (*okta.Response
 	return m.requestExecutor.Do(req, nil)
 }
 
+func (m *ApiSupplement) ListAuthorizationServerKeys(id string) ([]*AuthServerKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authorizationServers/%s/credentials/keys", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*AuthServerKey
+	resp, err := m.requestExecutor.Do(req, &keys)
+	return keys, resp, err
+}
+
+// The ACTIVE key becomes EXPIRED, the NEXT key becomes ACTIVE and a new NEXT key is generated
+func (m *ApiSupplement) RotateAuthorizationServerKeys(id string) ([]*AuthServerKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authorizationServers/%s/credentials/lifecycle/keyRotate", id)
+	req, err := m.requestExecutor.NewRequest("POST", url, keyRotation{Use: "sig"})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*AuthServerKey
+	resp, err := m.requestExecutor.Do(req, &keys)
+	return keys, resp, err
+}
+
 func (c *ApiSupplement) FindAuthServer(name string, qp *query.Params) (*AuthorizationServer, error) {
 	authServerList, res, err := c.ListAuthorizationServers()
 	if err != nil {
@@ -160,3 +237,30 @@ func getNextLinkOffset(res *okta.Response) string {
 
 	return ""
 }
+
+// Public endpoint, served outside of /api/v1
+func (m *ApiSupplement) GetAuthorizationServerMetadata(id string) (*AuthServerMetadata, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/%s/.well-known/openid-configuration", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := &AuthServerMetadata{}
+	resp, err := m.requestExecutor.Do(req, metadata)
+	return metadata, resp, err
+}
+
+// Public endpoint, served outside of /api/v1. The key set is returned as served, JSONWebKeySet only models some of
+// the members of a key.
+func (m *ApiSupplement) GetAuthorizationServerJwks(id string) (json.RawMessage, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/%s/v1/keys", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var jwks json.RawMessage
+	resp, err := m.requestExecutor.Do(req, &jwks)
+	return jwks, resp, err
+}
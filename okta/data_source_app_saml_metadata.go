@@ -0,0 +1,144 @@
+package okta
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+func dataSourceAppSamlMetadata() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAppSamlMetadataRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Certificate the metadata is generated for, defaults to the active one.",
+			},
+			"metadata": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SAML xml metadata payload",
+			},
+			"entity_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_post_binding": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Post location from the SAML metadata.",
+			},
+			"http_redirect_binding": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect location from the SAML metadata.",
+			},
+			"certificates": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every signing certificate of the application.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Base64 encoded DER certificate",
+						},
+						"expires_at": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fingerprint_sha256": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppSamlMetadataRead(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	app := okta.NewSamlApplication()
+	if _, _, err := getOktaClientFromMetadata(m).Application.GetApplication(appId, app, nil); err != nil {
+		return fmt.Errorf("failed to get SAML application %s: %v", appId, err)
+	}
+	activeKid := ""
+	if app.Credentials != nil && app.Credentials.Signing != nil {
+		activeKid = app.Credentials.Signing.Kid
+	}
+
+	keyId := d.Get("key_id").(string)
+	if keyId == "" {
+		keyId = activeKid
+	}
+
+	raw, _, err := getSupplementFromMetadata(m).GetSAMLMetdata(appId, keyId)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return fmt.Errorf("no SAML metadata found for application %s and key %s", appId, keyId)
+	}
+	metadataRoot, err := parseSamlMetadata(raw)
+	if err != nil {
+		return err
+	}
+
+	keys, _, err := getOktaClientFromMetadata(m).Application.ListApplicationKeys(appId)
+	if err != nil {
+		return fmt.Errorf("failed to list certificates of application %s: %v", appId, err)
+	}
+	certificates := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if len(key.X5c) == 0 {
+			continue
+		}
+		cert, err := parseCertificate(key.X5c[0])
+		if err != nil {
+			return fmt.Errorf("failed to parse certificate %s of application %s: %v", key.Kid, appId, err)
+		}
+		certificates = append(certificates, map[string]interface{}{
+			"kid":                key.Kid,
+			"certificate":        key.X5c[0],
+			"expires_at":         formatKeyTime(&cert.NotAfter),
+			"fingerprint_sha256": certificateFingerprint(cert),
+			"active":             key.Kid == activeKid,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", appId, keyId))
+	d.Set("key_id", keyId)
+	d.Set("metadata", string(raw))
+	d.Set("entity_id", metadataRoot.EntityID)
+	if len(metadataRoot.IDPSSODescriptors) > 0 {
+		for _, service := range metadataRoot.IDPSSODescriptors[0].SingleSignOnServices {
+			switch service.Binding {
+			case postBinding:
+				d.Set("http_post_binding", service.Location)
+			case redirectBinding:
+				d.Set("http_redirect_binding", service.Location)
+			}
+		}
+	}
+
+	return setNonPrimitives(d, map[string]interface{}{"certificates": certificates})
+}
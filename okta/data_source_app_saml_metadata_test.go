@@ -0,0 +1,37 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOktaDataSourceAppSamlMetadata(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSamlMetadata)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", appSamlMetadata)
+	keyResourceName := fmt.Sprintf("data.%s.test_key", appSamlMetadata)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "metadata"),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_post_binding"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_redirect_binding"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificates.0.fingerprint_sha256"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificates.0.expires_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "okta_app_saml.test", "key_id"),
+					resource.TestCheckResourceAttrPair(keyResourceName, "metadata", "okta_app_saml.test", "metadata"),
+				),
+			},
+		},
+	})
+}
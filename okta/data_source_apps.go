@@ -0,0 +1,128 @@
+package okta
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var appSignOnModes = []string{
+	"AUTO_LOGIN",
+	"BASIC_AUTH",
+	"BOOKMARK",
+	"BROWSER_PLUGIN",
+	"OPENID_CONNECT",
+	"SAML_1_1",
+	"SAML_2_0",
+	"SECURE_PASSWORD_STORE",
+	"WS_FEDERATION",
+}
+
+// Lists every app matching the filters, unlike okta_app which returns a single one.
+func dataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAppsRead,
+
+		Schema: map[string]*schema.Schema{
+			"sign_on_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(appSignOnModes, false),
+				Description:  "Only return apps with this sign on mode.",
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "INACTIVE"}, false),
+				Description:  "Only return apps with this status.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps with this catalog name, such as oidc_client or amazon_aws.",
+			},
+			"label_regex": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				Description:  "Only return apps whose label matches this regular expression.",
+			},
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return apps assigned to this group.",
+			},
+			"apps": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sign_on_mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppsRead(d *schema.ResourceData, m interface{}) error {
+	filters := &appFilters{
+		Name:       d.Get("name").(string),
+		SignOnMode: d.Get("sign_on_mode").(string),
+		Status:     d.Get("status").(string),
+	}
+	if labelRegex := d.Get("label_regex").(string); labelRegex != "" {
+		// Already validated
+		filters.LabelRegex = regexp.MustCompile(labelRegex)
+	}
+
+	// Group assignments are not part of the app listing, so this is the one filter Okta applies. The others are
+	// applied to each page of results.
+	groupId := d.Get("group_id").(string)
+	if groupId != "" {
+		filters.ApiFilter = fmt.Sprintf(`group.id eq "%s"`, groupId)
+	}
+
+	appList, err := listApps(m, filters)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s/%s/%s/%s/%s", filters.SignOnMode, filters.Status,
+		filters.Name, d.Get("label_regex").(string), groupId))))
+	arr := make([]map[string]interface{}, len(appList))
+
+	for i, app := range appList {
+		arr[i] = map[string]interface{}{
+			"id":           app.ID,
+			"label":        app.Label,
+			"name":         app.Name,
+			"sign_on_mode": app.SignOnMode,
+			"status":       app.Status,
+		}
+	}
+
+	return d.Set("apps", arr)
+}
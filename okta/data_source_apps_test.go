@@ -0,0 +1,36 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceApps(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager("okta_apps")
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_apps.oidc", "apps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_apps.oidc", "apps.0.id", "okta_app_oauth.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_apps.oidc", "apps.0.name", "oidc_client"),
+					resource.TestCheckResourceAttr("data.okta_apps.oidc", "apps.0.sign_on_mode", "OPENID_CONNECT"),
+					resource.TestCheckResourceAttr("data.okta_apps.inactive", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_apps.inactive", "apps.0.label", fmt.Sprintf("testAcc_%d_bookmark", ri)),
+					resource.TestCheckResourceAttr("data.okta_apps.inactive", "apps.0.status", "INACTIVE"),
+				),
+			},
+		},
+	})
+}
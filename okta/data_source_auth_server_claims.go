@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAuthServerClaims() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuthServerClaimsRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"claims": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"claim_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_filter_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"always_include_in_token": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"scopes": &schema.Schema{
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerClaimsRead(d *schema.ResourceData, m interface{}) error {
+	authServerId := d.Get("auth_server_id").(string)
+	claims, _, err := getSupplementFromMetadata(m).ListAuthorizationServerClaims(authServerId)
+	if err != nil {
+		return err
+	}
+
+	arr := make([]map[string]interface{}, len(claims))
+	for i, claim := range claims {
+		var scopes []string
+		if claim.Conditions != nil {
+			scopes = claim.Conditions.Scopes
+		}
+
+		arr[i] = map[string]interface{}{
+			"id":                      claim.Id,
+			"name":                    claim.Name,
+			"status":                  claim.Status,
+			"claim_type":              claim.ClaimType,
+			"value_type":              claim.ValueType,
+			"value":                   claim.Value,
+			"group_filter_type":       claim.GroupFilterType,
+			"always_include_in_token": claim.AlwaysIncludeInToken,
+			"scopes":                  convertStringSetToInterface(scopes),
+		}
+	}
+	d.SetId(authServerId)
+
+	return d.Set("claims", arr)
+}
@@ -0,0 +1,162 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAuthServerMetadata() *schema.Resource {
+	stringList := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAuthServerMetadataRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"issuer": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"userinfo_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"introspection_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revocation_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_session_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registration_endpoint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scopes_supported":                      stringList,
+			"claims_supported":                      stringList,
+			"grant_types_supported":                 stringList,
+			"response_types_supported":              stringList,
+			"token_endpoint_auth_methods_supported": stringList,
+			"id_token_signing_alg_values_supported": stringList,
+			"code_challenge_methods_supported":      stringList,
+			"jwks": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Public signing keys of the authorization server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kty": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alg": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"e": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"n": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"jwks_json": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public key set as a JSON document, as served at jwks_uri.",
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerMetadataRead(d *schema.ResourceData, m interface{}) error {
+	authServerId := d.Get("auth_server_id").(string)
+	client := getSupplementFromMetadata(m)
+	metadata, _, err := client.GetAuthorizationServerMetadata(authServerId)
+	if err != nil {
+		return fmt.Errorf("failed to get discovery document of authorization server %s: %v", authServerId, err)
+	}
+
+	jwksJson, _, err := client.GetAuthorizationServerJwks(authServerId)
+	if err != nil {
+		return fmt.Errorf("failed to get keys of authorization server %s: %v", authServerId, err)
+	}
+
+	jwks := &JSONWebKeySet{}
+	if err := json.Unmarshal(jwksJson, jwks); err != nil {
+		return fmt.Errorf("failed to parse keys of authorization server %s: %v", authServerId, err)
+	}
+
+	keys := make([]map[string]interface{}, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		keys[i] = map[string]interface{}{
+			"alg": key.Alg,
+			"e":   key.E,
+			"kid": key.Kid,
+			"kty": key.Kty,
+			"n":   key.N,
+			"use": key.Use,
+		}
+	}
+
+	d.SetId(authServerId)
+	d.Set("issuer", metadata.Issuer)
+	d.Set("authorization_endpoint", metadata.AuthorizationEndpoint)
+	d.Set("token_endpoint", metadata.TokenEndpoint)
+	d.Set("userinfo_endpoint", metadata.UserinfoEndpoint)
+	d.Set("introspection_endpoint", metadata.IntrospectionEndpoint)
+	d.Set("revocation_endpoint", metadata.RevocationEndpoint)
+	d.Set("end_session_endpoint", metadata.EndSessionEndpoint)
+	d.Set("registration_endpoint", metadata.RegistrationEndpoint)
+	d.Set("jwks_uri", metadata.JwksUri)
+	d.Set("jwks_json", string(jwksJson))
+
+	return setNonPrimitives(d, map[string]interface{}{
+		"scopes_supported":                      convertStringArrToInterface(metadata.ScopesSupported),
+		"claims_supported":                      convertStringArrToInterface(metadata.ClaimsSupported),
+		"grant_types_supported":                 convertStringArrToInterface(metadata.GrantTypesSupported),
+		"response_types_supported":              convertStringArrToInterface(metadata.ResponseTypesSupported),
+		"token_endpoint_auth_methods_supported": convertStringArrToInterface(metadata.TokenEndpointAuthMethods),
+		"id_token_signing_alg_values_supported": convertStringArrToInterface(metadata.IdTokenSigningAlgValues),
+		"code_challenge_methods_supported":      convertStringArrToInterface(metadata.CodeChallengeMethodsSupported),
+		"jwks":                                  keys,
+	})
+}
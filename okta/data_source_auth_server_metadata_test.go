@@ -0,0 +1,50 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Members the provider does not model, such as the certificate chain, must be kept in jwks_json.
+const standInJwks = `{"keys":[{"kty":"RSA","alg":"RS256","kid":"standin-kid","use":"sig","e":"AQAB","n":"standin-n","x5c":["MIIC"],"x5t#S256":"standin-thumbprint","status":"ACTIVE"}]}`
+
+func TestAuthServerMetadataJwksStandIn(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/ausstandin/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issuer":"https://standin.okta.com/oauth2/ausstandin","jwks_uri":"https://standin.okta.com/oauth2/ausstandin/v1/keys"}`)
+	})
+	mux.HandleFunc("/oauth2/ausstandin/v1/keys", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, standInJwks)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+
+data "okta_auth_server_metadata" "test" {
+  auth_server_id = "ausstandin"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: standInProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_auth_server_metadata.test", "jwks_json", standInJwks),
+					resource.TestCheckResourceAttr("data.okta_auth_server_metadata.test", "jwks.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_auth_server_metadata.test", "jwks.0.kid", "standin-kid"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package okta
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAuthServerNested(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authServer)
+	config := mgr.GetFixtures("datasource_nested.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_auth_server_scopes.test", "scopes.#"),
+					resource.TestCheckResourceAttrSet("data.okta_auth_server_claims.test", "claims.#"),
+					resource.TestCheckResourceAttrPair("data.okta_auth_server_policy.test", "id", "okta_auth_server_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_auth_server_policy.test", "client_whitelist.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_auth_server_metadata.test", "issuer", "okta_auth_server.test", "issuer"),
+					resource.TestMatchResourceAttr("data.okta_auth_server_metadata.test", "token_endpoint", regexp.MustCompile(`/v1/token$`)),
+					resource.TestCheckResourceAttrSet("data.okta_auth_server_metadata.test", "jwks.0.kid"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package okta
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAuthServerPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuthServerPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"priority": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"client_whitelist": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerPolicyRead(d *schema.ResourceData, m interface{}) error {
+	authServerId := d.Get("auth_server_id").(string)
+	name := d.Get("name").(string)
+	policies, _, err := getSupplementFromMetadata(m).ListAuthorizationServerPolicies(authServerId)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.Name != name {
+			continue
+		}
+
+		d.SetId(policy.Id)
+		d.Set("description", policy.Description)
+		d.Set("status", policy.Status)
+		d.Set("priority", policy.Priority)
+
+		if policy.Conditions != nil && policy.Conditions.Clients != nil {
+			return d.Set("client_whitelist", convertStringSetToInterface(policy.Conditions.Clients.Include))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No authorization server policy found with provided name %s", name)
+}
@@ -0,0 +1,83 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAuthServerScopes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuthServerScopesRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scopes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consent": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata_publish": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"system": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerScopesRead(d *schema.ResourceData, m interface{}) error {
+	authServerId := d.Get("auth_server_id").(string)
+	scopes, _, err := getSupplementFromMetadata(m).ListAuthorizationServerScopes(authServerId)
+	if err != nil {
+		return err
+	}
+
+	arr := make([]map[string]interface{}, len(scopes))
+	for i, scope := range scopes {
+		arr[i] = map[string]interface{}{
+			"id":               scope.Id,
+			"name":             scope.Name,
+			"description":      scope.Description,
+			"display_name":     scope.DisplayName,
+			"consent":          scope.Consent,
+			"metadata_publish": scope.MetadataPublish,
+			"default":          scope.Default,
+			"system":           scope.System,
+		}
+	}
+	d.SetId(authServerId)
+
+	return d.Set("scopes", arr)
+}
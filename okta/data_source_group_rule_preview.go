@@ -0,0 +1,150 @@
+package okta
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
+// Previews which users a group rule expression would match. The expression is evaluated locally, Okta does not offer
+// a dry run for group rules.
+func dataSourceGroupRulePreview() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupRulePreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"expression_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Default:  "urn:okta:expression:1.0",
+				Optional: true,
+			},
+			"expression_value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"logins": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"match_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users the expression matches.",
+			},
+			"user_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of users the expression was evaluated against.",
+			},
+		},
+	}
+}
+
+func dataSourceGroupRulePreviewRead(d *schema.ResourceData, m interface{}) error {
+	expression := d.Get("expression_value").(string)
+	node, err := parseExpression(expression)
+	if err != nil {
+		return fmt.Errorf("failed to parse expression_value: %v", err)
+	}
+
+	client := getOktaClientFromMetadata(m)
+	results := &searchResults{Users: []*okta.User{}}
+	if err := collectUsers(client, results, &query.Params{Limit: 200}); err != nil {
+		return fmt.Errorf("Error Getting Users from Okta: %v", err)
+	}
+
+	resolver := &apiGroupMembershipResolver{client: client, members: map[string][]string{}}
+	var userIds, logins []string
+
+	for _, user := range results.Users {
+		var profile map[string]interface{}
+		if user.Profile != nil {
+			profile = *user.Profile
+		}
+
+		matched, err := evaluateExpression(node, &expressionUser{id: user.Id, profile: profile, groups: resolver})
+		if err != nil {
+			return fmt.Errorf("failed to evaluate expression for user %s: %v", user.Id, err)
+		}
+
+		if matched {
+			userIds = append(userIds, user.Id)
+			if login, ok := profile["login"].(string); ok {
+				logins = append(logins, login)
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(d.Get("expression_type").(string)+expression)))
+	d.Set("match_count", len(userIds))
+	d.Set("user_count", len(results.Users))
+
+	return setNonPrimitives(d, map[string]interface{}{
+		"user_ids": convertStringSetToInterface(userIds),
+		"logins":   convertStringSetToInterface(logins),
+	})
+}
+
+// Lazily fetches and caches group memberships, most expressions only reference a handful of groups.
+type apiGroupMembershipResolver struct {
+	client  *okta.Client
+	groups  []*okta.Group
+	members map[string][]string
+}
+
+func (r *apiGroupMembershipResolver) isMemberOfGroupIds(userId string, groupIds []string) (bool, error) {
+	for _, id := range groupIds {
+		members, err := r.groupMembers(id)
+		if err != nil {
+			return false, err
+		}
+
+		if contains(members, userId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *apiGroupMembershipResolver) isMemberOfGroupName(userId string, match func(string) bool) (bool, error) {
+	if r.groups == nil {
+		groups, err := collectGroups(r.client, []*okta.Group{}, &query.Params{Limit: 200})
+		if err != nil {
+			return false, err
+		}
+		r.groups = groups
+	}
+
+	var ids []string
+	for _, g := range r.groups {
+		if g.Profile != nil && match(g.Profile.Name) {
+			ids = append(ids, g.Id)
+		}
+	}
+
+	return r.isMemberOfGroupIds(userId, ids)
+}
+
+func (r *apiGroupMembershipResolver) groupMembers(id string) ([]string, error) {
+	if members, ok := r.members[id]; ok {
+		return members, nil
+	}
+
+	members, err := collectGroupUserIds(r.client, id, []string{}, &query.Params{Limit: 200})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of group %s: %v", id, err)
+	}
+	r.members[id] = members
+
+	return members, nil
+}
@@ -0,0 +1,29 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGroupRulePreview(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupRulePreview)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_group_rule_preview.test", "match_count", "1"),
+					resource.TestCheckResourceAttr("data.okta_group_rule_preview.test", "user_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("data.okta_group_rule_preview.test", "user_count"),
+				),
+			},
+		},
+	})
+}
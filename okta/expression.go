@@ -0,0 +1,630 @@
+package okta
+
+// A small local evaluator for the Okta Expression Language, as used by group rules. It only covers what group rules
+// commonly use: user profile comparisons, boolean logic, String.* functions and isMemberOf* functions. Anything else
+// is reported as unsupported when parsing rather than guessed at, a wrong preview is worse than no preview.
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type (
+	// Resolves group memberships for the isMemberOf* functions, implemented against the API by the data source.
+	groupMembershipResolver interface {
+		isMemberOfGroupIds(userId string, groupIds []string) (bool, error)
+		isMemberOfGroupName(userId string, match func(string) bool) (bool, error)
+	}
+
+	expressionUser struct {
+		id      string
+		profile map[string]interface{}
+		groups  groupMembershipResolver
+	}
+
+	expressionNode interface {
+		eval(u *expressionUser) (interface{}, error)
+	}
+
+	unsupportedExpressionError struct {
+		construct string
+	}
+
+	expressionToken struct {
+		kind  string
+		value string
+		pos   int
+	}
+
+	expressionParser struct {
+		tokens []*expressionToken
+		pos    int
+	}
+
+	literalNode struct {
+		value interface{}
+	}
+
+	attributeNode struct {
+		name string
+	}
+
+	notNode struct {
+		operand expressionNode
+	}
+
+	logicalNode struct {
+		operator    string
+		left, right expressionNode
+	}
+
+	comparisonNode struct {
+		operator    string
+		left, right expressionNode
+	}
+
+	functionNode struct {
+		name string
+		args []expressionNode
+	}
+)
+
+const (
+	tokenIdent  = "ident"
+	tokenNumber = "number"
+	tokenOp     = "op"
+	tokenString = "string"
+)
+
+func (e *unsupportedExpressionError) Error() string {
+	return fmt.Sprintf("unsupported expression construct %s, the expression cannot be evaluated locally", e.construct)
+}
+
+// Functions that can be evaluated locally along with their arity, -1 being variadic.
+var expressionFunctions = map[string]int{
+	"String.append":                 2,
+	"String.endsWith":               2,
+	"String.join":                   -1,
+	"String.len":                    1,
+	"String.removeSpaces":           1,
+	"String.replace":                3,
+	"String.replaceFirst":           3,
+	"String.startsWith":             2,
+	"String.stringContains":         2,
+	"String.substring":              3,
+	"String.substringAfter":         2,
+	"String.substringBefore":        2,
+	"String.toLowerCase":            1,
+	"String.toUpperCase":            1,
+	"isMemberOfAnyGroup":            -1,
+	"isMemberOfGroup":               1,
+	"isMemberOfGroupName":           1,
+	"isMemberOfGroupNameContains":   1,
+	"isMemberOfGroupNameRegex":      1,
+	"isMemberOfGroupNameStartsWith": 1,
+}
+
+// Parses an expression, unsupported constructs are reported as an unsupportedExpressionError.
+func parseExpression(expression string) (expressionNode, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &expressionParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Trailing identifiers are operators we do not know about, such as matches
+	if tok := p.peek(); tok != nil && tok.kind == tokenIdent {
+		return nil, &unsupportedExpressionError{fmt.Sprintf("operator %s at position %d", tok.value, tok.pos)}
+	} else if tok != nil {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.value, tok.pos)
+	}
+
+	return node, nil
+}
+
+// Evaluates a parsed expression for a user, group rule expressions must evaluate to a boolean.
+func evaluateExpression(node expressionNode, u *expressionUser) (bool, error) {
+	val, err := node.eval(u)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := val.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v, expected a boolean", val)
+	}
+
+	return b, nil
+}
+
+func tokenizeExpression(expression string) ([]*expressionToken, error) {
+	var tokens []*expressionToken
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			var sb strings.Builder
+			start := i
+			i++
+			for ; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			i++
+			tokens = append(tokens, &expressionToken{tokenString, sb.String(), start})
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, &expressionToken{tokenNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || strings.ContainsRune("_$.", runes[i])) {
+				i++
+			}
+			tokens = append(tokens, &expressionToken{tokenIdent, string(runes[start:i]), start})
+		default:
+			start := i
+			if i+1 < len(runes) {
+				if two := string(runes[i : i+2]); contains([]string{"==", "!=", "<=", ">=", "&&", "||"}, two) {
+					tokens = append(tokens, &expressionToken{tokenOp, two, start})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("()<>!,", r) {
+				return nil, &unsupportedExpressionError{fmt.Sprintf("%q at position %d", r, start)}
+			}
+			tokens = append(tokens, &expressionToken{tokenOp, string(r), start})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *expressionParser) peek() *expressionToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return nil
+}
+
+func (p *expressionParser) next() *expressionToken {
+	tok := p.peek()
+	p.pos++
+
+	return tok
+}
+
+// Keyword operators are case insensitive, AND and and are the same thing
+func (p *expressionParser) accept(values ...string) (string, bool) {
+	tok := p.peek()
+	if tok == nil || tok.kind == tokenString || tok.kind == tokenNumber {
+		return "", false
+	}
+
+	for _, v := range values {
+		if tok.value == v || (tok.kind == tokenIdent && strings.EqualFold(tok.value, v)) {
+			p.pos++
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func (p *expressionParser) expect(value string) error {
+	tok := p.next()
+	if tok == nil {
+		return fmt.Errorf("unexpected end of expression, expected %q", value)
+	}
+	if tok.value != value {
+		return fmt.Errorf("unexpected %q at position %d, expected %q", tok.value, tok.pos, value)
+	}
+
+	return nil
+}
+
+func (p *expressionParser) parseOr() (expressionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, ok := p.accept("||", "OR"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{"OR", left, right}
+	}
+}
+
+func (p *expressionParser) parseAnd() (expressionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, ok := p.accept("&&", "AND"); !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{"AND", left, right}
+	}
+}
+
+func (p *expressionParser) parseUnary() (expressionNode, error) {
+	if _, ok := p.accept("!", "NOT"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *expressionParser) parseComparison() (expressionNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if op, ok := p.accept("==", "!=", "<=", ">=", "<", ">"); ok {
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return &comparisonNode{op, left, right}, nil
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parsePrimary() (expressionNode, error) {
+	tok := p.next()
+	if tok == nil {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	switch tok.kind {
+	case tokenString:
+		return &literalNode{tok.value}, nil
+	case tokenNumber:
+		num, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.value, tok.pos)
+		}
+		return &literalNode{num}, nil
+	case tokenOp:
+		if tok.value != "(" {
+			return nil, fmt.Errorf("unexpected %q at position %d", tok.value, tok.pos)
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect(")")
+	}
+
+	if next := p.peek(); next != nil && next.value == "(" {
+		return p.parseFunction(tok)
+	}
+
+	switch strings.ToLower(tok.value) {
+	case "true":
+		return &literalNode{true}, nil
+	case "false":
+		return &literalNode{false}, nil
+	case "null":
+		return &literalNode{nil}, nil
+	}
+
+	if strings.HasPrefix(tok.value, "user.") && strings.Count(tok.value, ".") == 1 {
+		return &attributeNode{strings.TrimPrefix(tok.value, "user.")}, nil
+	}
+
+	return nil, &unsupportedExpressionError{tok.value}
+}
+
+func (p *expressionParser) parseFunction(name *expressionToken) (expressionNode, error) {
+	arity, ok := expressionFunctions[name.value]
+	if !ok {
+		return nil, &unsupportedExpressionError{fmt.Sprintf("function %s", name.value)}
+	}
+	p.next() // (
+
+	var args []expressionNode
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if arity >= 0 && len(args) != arity {
+		return nil, fmt.Errorf("%s expects %d arguments, got %d", name.value, arity, len(args))
+	}
+
+	return &functionNode{name.value, args}, nil
+}
+
+func (n *literalNode) eval(u *expressionUser) (interface{}, error) {
+	return n.value, nil
+}
+
+func (n *attributeNode) eval(u *expressionUser) (interface{}, error) {
+	// Numbers are all float64 when coming from JSON, integers in the expression are parsed the same way
+	switch v := u.profile[n.name].(type) {
+	case int:
+		return float64(v), nil
+	default:
+		return v, nil
+	}
+}
+
+func (n *notNode) eval(u *expressionUser) (interface{}, error) {
+	val, err := evalBool(n.operand, u)
+
+	return !val, err
+}
+
+func (n *logicalNode) eval(u *expressionUser) (interface{}, error) {
+	left, err := evalBool(n.left, u)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.operator == "AND" && !left {
+		return false, nil
+	} else if n.operator == "OR" && left {
+		return true, nil
+	}
+
+	return evalBool(n.right, u)
+}
+
+func (n *comparisonNode) eval(u *expressionUser) (interface{}, error) {
+	left, err := n.left.eval(u)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Array attributes cannot be compared with ==, it would panic
+	switch n.operator {
+	case "==":
+		return reflect.DeepEqual(left, right), nil
+	case "!=":
+		return !reflect.DeepEqual(left, right), nil
+	}
+
+	// Ordering comparisons against null or mismatched types never match
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return false, nil
+		}
+		return compareOrdered(n.operator, l < r, l == r), nil
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false, nil
+		}
+		return compareOrdered(n.operator, l < r, l == r), nil
+	}
+
+	return false, nil
+}
+
+func compareOrdered(operator string, less, equal bool) bool {
+	switch operator {
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	case ">":
+		return !less && !equal
+	default:
+		return !less
+	}
+}
+
+func (n *functionNode) eval(u *expressionUser) (interface{}, error) {
+	if strings.HasPrefix(n.name, "isMemberOf") {
+		return n.evalMembership(u)
+	}
+
+	args := make([]string, len(n.args))
+	for i, arg := range n.args {
+		val, err := arg.eval(u)
+		if err != nil {
+			return nil, err
+		}
+		// String functions treat null as an empty string
+		if val != nil {
+			args[i] = stringifyExpressionValue(val)
+		}
+	}
+
+	switch n.name {
+	case "String.append":
+		return args[0] + args[1], nil
+	case "String.endsWith":
+		return strings.HasSuffix(args[0], args[1]), nil
+	case "String.join":
+		if len(args) < 1 {
+			return "", nil
+		}
+		return strings.Join(args[1:], args[0]), nil
+	case "String.len":
+		return float64(len([]rune(args[0]))), nil
+	case "String.removeSpaces":
+		return strings.Replace(args[0], " ", "", -1), nil
+	case "String.replace", "String.replaceFirst":
+		re, err := regexp.Compile(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid regular expression %q: %v", n.name, args[1], err)
+		}
+		if n.name == "String.replace" {
+			return re.ReplaceAllString(args[0], args[2]), nil
+		}
+		loc := re.FindStringSubmatchIndex(args[0])
+		if loc == nil {
+			return args[0], nil
+		}
+		return args[0][:loc[0]] + string(re.ExpandString(nil, args[2], args[0], loc)) + args[0][loc[1]:], nil
+	case "String.startsWith":
+		return strings.HasPrefix(args[0], args[1]), nil
+	case "String.stringContains":
+		return strings.Contains(args[0], args[1]), nil
+	case "String.substring":
+		return substringExpression(args[0], args[1], args[2])
+	case "String.substringAfter":
+		if i := strings.Index(args[0], args[1]); i >= 0 {
+			return args[0][i+len(args[1]):], nil
+		}
+		return "", nil
+	case "String.substringBefore":
+		if i := strings.Index(args[0], args[1]); i >= 0 {
+			return args[0][:i], nil
+		}
+		return "", nil
+	case "String.toLowerCase":
+		return strings.ToLower(args[0]), nil
+	case "String.toUpperCase":
+		return strings.ToUpper(args[0]), nil
+	}
+
+	return nil, &unsupportedExpressionError{fmt.Sprintf("function %s", n.name)}
+}
+
+func (n *functionNode) evalMembership(u *expressionUser) (interface{}, error) {
+	if u.groups == nil {
+		return nil, &unsupportedExpressionError{fmt.Sprintf("function %s without group data", n.name)}
+	}
+
+	args := make([]string, len(n.args))
+	for i, arg := range n.args {
+		val, err := arg.eval(u)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s expects string arguments, got %v", n.name, val)
+		}
+		args[i] = s
+	}
+
+	switch n.name {
+	case "isMemberOfGroup", "isMemberOfAnyGroup":
+		return u.groups.isMemberOfGroupIds(u.id, args)
+	case "isMemberOfGroupName":
+		return u.groups.isMemberOfGroupName(u.id, func(name string) bool { return name == args[0] })
+	case "isMemberOfGroupNameContains":
+		return u.groups.isMemberOfGroupName(u.id, func(name string) bool { return strings.Contains(name, args[0]) })
+	case "isMemberOfGroupNameStartsWith":
+		return u.groups.isMemberOfGroupName(u.id, func(name string) bool { return strings.HasPrefix(name, args[0]) })
+	case "isMemberOfGroupNameRegex":
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid regular expression %q: %v", n.name, args[0], err)
+		}
+		return u.groups.isMemberOfGroupName(u.id, re.MatchString)
+	}
+
+	return nil, &unsupportedExpressionError{fmt.Sprintf("function %s", n.name)}
+}
+
+func evalBool(node expressionNode, u *expressionUser) (bool, error) {
+	val, err := node.eval(u)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := val.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, got %v", val)
+	}
+
+	return b, nil
+}
+
+func stringifyExpressionValue(val interface{}) string {
+	if f, ok := val.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%v", val)
+}
+
+func substringExpression(s, rawStart, rawEnd string) (interface{}, error) {
+	runes := []rune(s)
+	start, err := strconv.Atoi(rawStart)
+	if err != nil {
+		return nil, fmt.Errorf("String.substring: invalid start index %q", rawStart)
+	}
+	end, err := strconv.Atoi(rawEnd)
+	if err != nil {
+		return nil, fmt.Errorf("String.substring: invalid end index %q", rawEnd)
+	}
+
+	// Indexes past the value are clamped, otherwise a single user with a short attribute would abort the whole preview
+	if start < 0 {
+		start = 0
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+	if start > end {
+		return "", nil
+	}
+
+	return string(runes[start:end]), nil
+}
@@ -0,0 +1,122 @@
+package okta
+
+import (
+	"testing"
+)
+
+type staticGroupMembership map[string][]string
+
+func (s staticGroupMembership) isMemberOfGroupIds(userId string, groupIds []string) (bool, error) {
+	for _, id := range groupIds {
+		if contains(s[id], userId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Group names double as IDs in this fake
+func (s staticGroupMembership) isMemberOfGroupName(userId string, match func(string) bool) (bool, error) {
+	for name, members := range s {
+		if match(name) && contains(members, userId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func TestEvaluateExpression(t *testing.T) {
+	user := &expressionUser{
+		id: "00u1",
+		profile: map[string]interface{}{
+			"login":         "jane.doe@example.com",
+			"department":    "Engineering",
+			"costCenter":    float64(42),
+			"articulateId":  "auth0|123",
+			"roles":         []interface{}{"admin", "dev"},
+			"previousRoles": []interface{}{"admin", "dev"},
+			"teams":         []interface{}{"platform"},
+		},
+		groups: staticGroupMembership{
+			"admins":    []string{"00u1"},
+			"engineers": []string{"00u2"},
+		},
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{`user.department == "Engineering"`, true},
+		{`user.department != "Engineering"`, false},
+		{`user.department == "Engineering" AND user.costCenter > 40`, true},
+		{`user.department == "Sales" OR user.costCenter >= 42`, true},
+		{`user.costCenter < 42`, false},
+		{`!(user.department == "Sales")`, true},
+		{`NOT user.department == "Engineering"`, false},
+		{`user.title == null`, true},
+		{`user.title > 1`, false},
+		{`user.roles == user.previousRoles`, true},
+		{`user.roles != user.teams`, true},
+		{`user.roles == "admin"`, false},
+		{`user.roles > user.teams`, false},
+		{`String.startsWith(user.articulateId,"auth0|")`, true},
+		{`String.startsWith(user.articulateId,String.toLowerCase("AUTH0|"))`, true},
+		{`String.stringContains(user.login, "@example.com") && String.endsWith(user.login, ".com")`, true},
+		{`String.toUpperCase(user.department) == 'ENGINEERING'`, true},
+		{`String.substringBefore(user.login, "@") == "jane.doe"`, true},
+		{`String.substringAfter(user.login, "@") == "example.com"`, true},
+		{`String.substring(user.department, 0, 3) == "Eng"`, true},
+		{`String.substring(user.department, 0, 50) == "Engineering"`, true},
+		{`String.substring(user.department, 20, 30) == ""`, true},
+		{`String.len(user.department) == 11`, true},
+		{`String.replace(user.login, "[.@]", "-") == "jane-doe-example-com"`, true},
+		{`String.replaceFirst(user.login, "[.@]", "-") == "jane-doe@example.com"`, true},
+		{`String.append(user.department, "!") == "Engineering!"`, true},
+		{`isMemberOfGroup("admins")`, true},
+		{`isMemberOfAnyGroup("engineers", "admins")`, true},
+		{`isMemberOfGroupName("engineers")`, false},
+		{`isMemberOfGroupNameStartsWith("adm")`, true},
+		{`isMemberOfGroupNameContains("min")`, true},
+		{`isMemberOfGroupNameRegex("^eng.*")`, false},
+	}
+
+	for _, test := range tests {
+		node, err := parseExpression(test.expression)
+		if err != nil {
+			t.Errorf("failed to parse %s: %v", test.expression, err)
+			continue
+		}
+
+		actual, err := evaluateExpression(node, user)
+		if err != nil {
+			t.Errorf("failed to evaluate %s: %v", test.expression, err)
+		} else if actual != test.expected {
+			t.Errorf("expression %s, expected %t, actual %t", test.expression, test.expected, actual)
+		}
+	}
+}
+
+func TestParseExpressionUnsupported(t *testing.T) {
+	tests := []string{
+		`user.department matches "Eng.*"`,
+		`Arrays.contains(user.roles, "admin")`,
+		`user.costCenter > 40 ? true : false`,
+		`appuser.department == "Engineering"`,
+		`user.manager.department == "Engineering"`,
+	}
+
+	for _, expression := range tests {
+		_, err := parseExpression(expression)
+		if err == nil {
+			t.Errorf("expected %s to fail parsing", expression)
+			continue
+		}
+
+		if _, ok := err.(*unsupportedExpressionError); !ok {
+			t.Errorf("expected %s to be reported as unsupported, got %v", expression, err)
+		}
+	}
+}
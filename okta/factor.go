@@ -0,0 +1,265 @@
+package okta
+
+// Not all APIs are supported by the articulate SDK, MFA enrollment policy factors being one of them. The SDK models
+// policy factors as a struct with a fixed set of fields, so anything Okta has added since is dropped on the floor.
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	articulateOkta "github.com/articulate/oktasdk-go/okta"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+// Catalog of factor keys accepted by MFA enrollment policies. When Okta ships a new authenticator, add its key here.
+var factorCatalog = []string{
+	"custom_hotp",
+	"custom_otp",
+	"duo",
+	"external_idp",
+	"fido_u2f",
+	"fido_webauthn",
+	"google_otp",
+	"okta_call",
+	"okta_email",
+	"okta_otp",
+	"okta_password",
+	"okta_push",
+	"okta_question",
+	"okta_sms",
+	"okta_verify",
+	"rsa_token",
+	"symantec_vip",
+	"yubikey_token",
+}
+
+type (
+	// MfaPolicy shadows the settings of the SDK policy so factors can be any key in the catalog.
+	MfaPolicy struct {
+		*articulateOkta.Policy
+		Settings *MfaPolicySettings `json:"settings,omitempty"`
+	}
+
+	MfaPolicySettings struct {
+		Factors map[string]*articulateOkta.FactorProvider `json:"factors,omitempty"`
+	}
+)
+
+func (m *ApiSupplement) GetMfaPolicy(id string) (*MfaPolicy, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := &MfaPolicy{Policy: &articulateOkta.Policy{}}
+	resp, err := m.requestExecutor.Do(req, policy)
+	return policy, resp, err
+}
+
+func (m *ApiSupplement) CreateMfaPolicy(body *MfaPolicy) (*MfaPolicy, *okta.Response, error) {
+	req, err := m.requestExecutor.NewRequest("POST", "/api/v1/policies", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := &MfaPolicy{Policy: &articulateOkta.Policy{}}
+	resp, err := m.requestExecutor.Do(req, policy)
+	return policy, resp, err
+}
+
+func (m *ApiSupplement) UpdateMfaPolicy(id string, body *MfaPolicy) (*MfaPolicy, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/policies/%s", id)
+	req, err := m.requestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := &MfaPolicy{Policy: &articulateOkta.Policy{}}
+	resp, err := m.requestExecutor.Do(req, policy)
+	return policy, resp, err
+}
+
+type (
+	OrgFactor struct {
+		Id         string             `json:"id,omitempty"`
+		FactorType string             `json:"factorType,omitempty"`
+		Provider   string             `json:"provider,omitempty"`
+		Status     string             `json:"status,omitempty"`
+		Settings   *OrgFactorSettings `json:"settings,omitempty"`
+	}
+
+	// Settings vary by factor provider, only the ones relevant to the provider are sent. Secrets are never returned.
+	OrgFactorSettings struct {
+		Duo         *DuoFactorSettings         `json:"duo,omitempty"`
+		Rsa         *RsaFactorSettings         `json:"rsa,omitempty"`
+		SymantecVip *SymantecVipFactorSettings `json:"symantecVip,omitempty"`
+		Telephony   *TelephonyFactorSettings   `json:"telephony,omitempty"`
+	}
+
+	DuoFactorSettings struct {
+		ApiHost        string `json:"apiHost,omitempty"`
+		IntegrationKey string `json:"integrationKey,omitempty"`
+		SecretKey      string `json:"secretKey,omitempty"`
+	}
+
+	RsaFactorSettings struct {
+		AccessKey string `json:"accessKey,omitempty"`
+		ApiUrl    string `json:"apiUrl,omitempty"`
+		ClientId  string `json:"clientId,omitempty"`
+	}
+
+	SymantecVipFactorSettings struct {
+		Certificate         string `json:"certificate,omitempty"`
+		CertificatePassword string `json:"certificatePassword,omitempty"`
+	}
+
+	TelephonyFactorSettings struct {
+		AccountSid string `json:"accountSid,omitempty"`
+		AuthToken  string `json:"authToken,omitempty"`
+		FromNumber string `json:"fromNumber,omitempty"`
+		Provider   string `json:"provider,omitempty"`
+	}
+
+	YubikeySeed struct {
+		AesKey       string `json:"aesKey"`
+		PrivateId    string `json:"privateId"`
+		PublicId     string `json:"publicId"`
+		SerialNumber string `json:"serialNumber"`
+	}
+)
+
+func (m *ApiSupplement) GetOrgFactor(id string) (*OrgFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/factors/%s", id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	factor := &OrgFactor{}
+	resp, err := m.requestExecutor.Do(req, factor)
+	return factor, resp, err
+}
+
+func (m *ApiSupplement) UpdateOrgFactor(id string, body OrgFactor) (*OrgFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/factors/%s", id)
+	req, err := m.requestExecutor.NewRequest("PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	factor := &OrgFactor{}
+	resp, err := m.requestExecutor.Do(req, factor)
+	return factor, resp, err
+}
+
+func (m *ApiSupplement) ActivateOrgFactor(id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/factors/%s/lifecycle/activate", id)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+func (m *ApiSupplement) DeactivateOrgFactor(id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/org/factors/%s/lifecycle/deactivate", id)
+	req, err := m.requestExecutor.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+func (m *ApiSupplement) UploadYubikeySeed(body YubikeySeed) (*okta.Response, error) {
+	req, err := m.requestExecutor.NewRequest("POST", "/api/v1/org/factors/yubikey_token/tokens", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
+
+type (
+	UserFactor struct {
+		Embedded   *UserFactorEmbedded `json:"_embedded,omitempty"`
+		FactorType string              `json:"factorType,omitempty"`
+		Id         string              `json:"id,omitempty"`
+		Profile    *UserFactorProfile  `json:"profile,omitempty"`
+		Provider   string              `json:"provider,omitempty"`
+		Status     string              `json:"status,omitempty"`
+		// Used to enroll hardware tokens, the pass code is the OTP generated by the token
+		Verify *UserFactorVerify `json:"verify,omitempty"`
+	}
+
+	UserFactorEmbedded struct {
+		Activation *UserFactorActivation `json:"activation,omitempty"`
+	}
+
+	UserFactorActivation struct {
+		SharedSecret string                    `json:"sharedSecret,omitempty"`
+		Links        *UserFactorActivationLink `json:"_links,omitempty"`
+	}
+
+	UserFactorActivationLink struct {
+		QrCode *struct {
+			Href string `json:"href,omitempty"`
+		} `json:"qrcode,omitempty"`
+	}
+
+	UserFactorProfile struct {
+		Answer       string `json:"answer,omitempty"`
+		CredentialId string `json:"credentialId,omitempty"`
+		Email        string `json:"email,omitempty"`
+		PhoneNumber  string `json:"phoneNumber,omitempty"`
+		Question     string `json:"question,omitempty"`
+		SharedSecret string `json:"sharedSecret,omitempty"`
+	}
+
+	UserFactorVerify struct {
+		PassCode string `json:"passCode,omitempty"`
+	}
+)
+
+// The SDK query params do not support factorProfileId, which custom HOTP factors require
+func (m *ApiSupplement) EnrollUserFactor(userId string, body UserFactor, activate bool, factorProfileId string) (*UserFactor, *okta.Response, error) {
+	qp := url.Values{}
+	qp.Set("activate", strconv.FormatBool(activate))
+	if factorProfileId != "" {
+		qp.Set("factorProfileId", factorProfileId)
+	}
+	uri := fmt.Sprintf("/api/v1/users/%s/factors?%s", userId, qp.Encode())
+	req, err := m.requestExecutor.NewRequest("POST", uri, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	factor := &UserFactor{}
+	resp, err := m.requestExecutor.Do(req, factor)
+	return factor, resp, err
+}
+
+func (m *ApiSupplement) GetUserFactor(userId, id string) (*UserFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors/%s", userId, id)
+	req, err := m.requestExecutor.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	factor := &UserFactor{}
+	resp, err := m.requestExecutor.Do(req, factor)
+	return factor, resp, err
+}
+
+func (m *ApiSupplement) DeleteUserFactor(userId, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors/%s", userId, id)
+	req, err := m.requestExecutor.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestExecutor.Do(req, nil)
+}
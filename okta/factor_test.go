@@ -0,0 +1,158 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Stand-in for the org factors, like Okta it keeps the secrets it receives but never returns them.
+type orgFactorStandIn struct {
+	sync.Mutex
+	factors map[string]*OrgFactor
+	secrets []string
+}
+
+func (s *orgFactorStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if r.URL.Path == "/api/v1/org/factors/yubikey_token/tokens" && r.Method == "POST" {
+		seed := YubikeySeed{}
+		json.NewDecoder(r.Body).Decode(&seed)
+		s.secrets = append(s.secrets, seed.PrivateId, seed.AesKey)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "{}")
+		return
+	}
+
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/org/factors/"), "/")
+	factor, ok := s.factors[path[0]]
+	if !ok {
+		writeStandInError(w, http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(path) == 1 && r.Method == "GET":
+		json.NewEncoder(w).Encode(factor)
+	case len(path) == 1 && r.Method == "PUT":
+		body := OrgFactor{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if duo := body.Settings.Duo; duo != nil {
+			s.secrets = append(s.secrets, duo.SecretKey)
+			duo.SecretKey = ""
+		}
+		if telephony := body.Settings.Telephony; telephony != nil {
+			s.secrets = append(s.secrets, telephony.AuthToken)
+			telephony.AuthToken = ""
+		}
+		factor.Settings = body.Settings
+		json.NewEncoder(w).Encode(factor)
+	case len(path) == 3 && r.Method == "POST":
+		if path[2] == "activate" {
+			factor.Status = "ACTIVE"
+		} else {
+			factor.Status = "INACTIVE"
+		}
+		fmt.Fprint(w, "{}")
+	default:
+		writeStandInError(w, http.StatusNotFound)
+	}
+}
+
+func TestFactorSecretsStandIn(t *testing.T) {
+	standIn := &orgFactorStandIn{factors: map[string]*OrgFactor{
+		"duo":           &OrgFactor{Id: "duo", Status: "INACTIVE"},
+		"okta_sms":      &OrgFactor{Id: "okta_sms", Status: "INACTIVE"},
+		"yubikey_token": &OrgFactor{Id: "yubikey_token", Status: "INACTIVE"},
+	}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	config := `
+provider "okta" {
+  org_name  = "standin"
+  api_token = "standin"
+}
+
+resource "okta_factor" "duo" {
+  provider_id = "duo"
+
+  duo {
+    integration_key = "DIXXXXXXXXXXXXXXXXXX"
+    secret_key      = "%s"
+    api_host        = "api-xxxxxxxx.duosecurity.com"
+  }
+}
+
+resource "okta_factor" "sms" {
+  provider_id = "okta_sms"
+
+  telephony {
+    provider    = "TWILIO"
+    account_sid = "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+    auth_token  = "twilio-token"
+    from_number = "+15555550100"
+  }
+}
+
+resource "okta_factor" "yubikey" {
+  provider_id = "yubikey_token"
+
+  yubikey_seed {
+    serial_number = "000004928451"
+    public_id     = "cccccceukngd"
+    private_id    = "yubikey-private-id"
+    aes_key       = "yubikey-aes-key"
+  }
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: standInProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, "duo-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("okta_factor.duo", "duo.0.secret_key", hashSecret("duo-secret")),
+					resource.TestCheckResourceAttr("okta_factor.duo", "duo.0.api_host", "api-xxxxxxxx.duosecurity.com"),
+					resource.TestCheckResourceAttr("okta_factor.sms", "telephony.0.auth_token", hashSecret("twilio-token")),
+					resource.TestCheckResourceAttr("okta_factor.yubikey", "yubikey_seed.0.private_id", hashSecret("yubikey-private-id")),
+					resource.TestCheckResourceAttr("okta_factor.yubikey", "yubikey_seed.0.aes_key", hashSecret("yubikey-aes-key")),
+					ensureStandInSecrets(standIn, "duo-secret", "twilio-token", "yubikey-private-id", "yubikey-aes-key"),
+				),
+			},
+			{
+				// The new secret is sent while the digest is all that is kept
+				Config: fmt.Sprintf(config, "rotated-duo-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("okta_factor.duo", "duo.0.secret_key", hashSecret("rotated-duo-secret")),
+					ensureStandInSecrets(standIn, "duo-secret", "twilio-token", "yubikey-private-id", "yubikey-aes-key", "rotated-duo-secret"),
+				),
+			},
+		},
+	})
+}
+
+func ensureStandInSecrets(standIn *orgFactorStandIn, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		standIn.Lock()
+		defer standIn.Unlock()
+
+		for _, secret := range expected {
+			if !contains(standIn.secrets, secret) {
+				return fmt.Errorf("expected secret %s to be sent, got %v", secret, standIn.secrets)
+			}
+		}
+
+		return nil
+	}
+}
@@ -1,5 +1,10 @@
 package okta
 
+import (
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
 func listGroupUserIds(m interface{}, id string) ([]string, error) {
 	client := getOktaClientFromMetadata(m)
 	arr, _, err := client.Group.ListGroupUsers(id, nil)
@@ -14,3 +19,39 @@ func listGroupUserIds(m interface{}, id string) ([]string, error) {
 
 	return userIdList, nil
 }
+
+// Recursively list groups until no next links are returned
+func collectGroups(client *okta.Client, groups []*okta.Group, qp *query.Params) ([]*okta.Group, error) {
+	page, res, err := client.Group.ListGroups(qp)
+	if err != nil {
+		return nil, err
+	}
+
+	groups = append(groups, page...)
+
+	if after := getAfterParam(res); after != "" {
+		qp.After = after
+		return collectGroups(client, groups, qp)
+	}
+
+	return groups, nil
+}
+
+// Recursively list group members until no next links are returned
+func collectGroupUserIds(client *okta.Client, id string, userIds []string, qp *query.Params) ([]string, error) {
+	users, res, err := client.Group.ListGroupUsers(id, qp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		userIds = append(userIds, user.Id)
+	}
+
+	if after := getAfterParam(res); after != "" {
+		qp.After = after
+		return collectGroupUserIds(client, id, userIds, qp)
+	}
+
+	return userIds, nil
+}
@@ -0,0 +1,45 @@
+package okta
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Some resources read, modify and write a shared parent, such as the redirect URIs of an application, or change state
+// the API computes across siblings, such as policy rule priorities. Terraform applies resources concurrently so these
+// are serialized per parent ID, otherwise the last write wins.
+var parentLocks = struct {
+	sync.Mutex
+	byId map[string]*sync.Mutex
+}{byId: map[string]*sync.Mutex{}}
+
+// Blocks until the lock of the parent is acquired, the returned function releases it. Locks are not reentrant.
+func lockParent(id string) func() {
+	parentLocks.Lock()
+	lock, ok := parentLocks.byId[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		parentLocks.byId[id] = lock
+	}
+	parentLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// Wraps a create, update or delete function so it holds the lock of the parent referenced by the given attribute,
+// "id" refers to the resource itself.
+func withParentLock(parentKey string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		id := d.Id()
+		if parentKey != "id" {
+			id = d.Get(parentKey).(string)
+		}
+
+		unlock := lockParent(id)
+		defer unlock()
+
+		return f(d, m)
+	}
+}
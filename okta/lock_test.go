@@ -0,0 +1,43 @@
+package okta
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockParent(t *testing.T) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	active := map[string]int{}
+	overlap := false
+
+	for i := 0; i < 10; i++ {
+		for _, parent := range []string{"app1", "app2"} {
+			wg.Add(1)
+			go func(parent string) {
+				defer wg.Done()
+				unlock := lockParent(parent)
+				defer unlock()
+
+				mu.Lock()
+				active[parent]++
+				if active[parent] > 1 {
+					overlap = true
+				}
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				active[parent]--
+				mu.Unlock()
+			}(parent)
+		}
+	}
+	wg.Wait()
+
+	if overlap {
+		t.Error("expected writes to the same parent to be serialized")
+	}
+}
@@ -52,14 +52,16 @@ var (
 	// Pattern used in a few spots, whitelisting/blacklisting users and groups
 	peopleSchema = map[string]*schema.Schema{
 		"user_whitelist": &schema.Schema{
-			Type:     schema.TypeSet,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "IDs of users to include.",
 		},
 		"user_blacklist": &schema.Schema{
-			Type:     schema.TypeSet,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "IDs of users to exclude.",
 		},
 		"group_whitelist": &schema.Schema{
 			Type:     schema.TypeSet,
@@ -86,13 +88,29 @@ func addPeopleAssignments(target map[string]*schema.Schema) map[string]*schema.S
 }
 
 func setPeopleAssignments(d *schema.ResourceData, c *okta.GroupRulePeopleCondition) error {
-	// Don't think the API omits these when they are empty thus the unguarded accessing
-	return setNonPrimitives(d, map[string]interface{}{
-		"group_whitelist": convertStringSetToInterface(c.Groups.Include),
-		"group_blacklist": convertStringSetToInterface(c.Groups.Exclude),
-		"user_whitelist":  convertStringSetToInterface(c.Users.Include),
-		"user_blacklist":  convertStringSetToInterface(c.Users.Exclude),
-	})
+	// The API omits users or groups when the rule only targets the other, what was removed upstream is cleared
+	people := map[string]interface{}{
+		"group_whitelist": []interface{}{},
+		"group_blacklist": []interface{}{},
+		"user_whitelist":  []interface{}{},
+		"user_blacklist":  []interface{}{},
+	}
+
+	if c == nil {
+		return setNonPrimitives(d, people)
+	}
+
+	if c.Groups != nil {
+		people["group_whitelist"] = convertStringSetToInterface(c.Groups.Include)
+		people["group_blacklist"] = convertStringSetToInterface(c.Groups.Exclude)
+	}
+
+	if c.Users != nil {
+		people["user_whitelist"] = convertStringSetToInterface(c.Users.Include)
+		people["user_blacklist"] = convertStringSetToInterface(c.Users.Exclude)
+	}
+
+	return setNonPrimitives(d, people)
 }
 
 func getPeopleConditions(d *schema.ResourceData) *okta.GroupRulePeopleCondition {
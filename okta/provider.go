@@ -13,20 +13,32 @@ import (
 // Resource names, defined in place, used throughout the provider and tests
 const (
 	appAutoLogin           = "okta_app_auto_login"
+	appBasicAuth           = "okta_app_basic_auth"
 	appBookmark            = "okta_app_bookmark"
+	appGroupPush           = "okta_app_group_push"
 	appOAuth               = "okta_app_oauth"
+	appOAuthApiScope       = "okta_app_oauth_api_scope"
+	appOAuthPostLogoutUri  = "okta_app_oauth_post_logout_redirect_uri"
 	appOAuthRedirectUri    = "okta_app_oauth_redirect_uri"
+	appProvisioning        = "okta_app_provisioning"
 	appSaml                = "okta_app_saml"
+	appSamlMetadata        = "okta_app_saml_metadata"
 	appSecurePasswordStore = "okta_app_secure_password_store"
 	appSwa                 = "okta_app_swa"
 	appThreeField          = "okta_app_three_field"
+	appWsFederation        = "okta_app_ws_federation"
 	authServer             = "okta_auth_server"
 	authServerClaim        = "okta_auth_server_claim"
+	authServerClaims       = "okta_auth_server_claims"
+	authServerDefault      = "okta_auth_server_default"
+	authServerMetadata     = "okta_auth_server_metadata"
 	authServerPolicy       = "okta_auth_server_policy"
 	authServerPolicyRule   = "okta_auth_server_policy_rule"
 	authServerScope        = "okta_auth_server_scope"
+	authServerScopes       = "okta_auth_server_scopes"
 	factor                 = "okta_factor"
 	groupRule              = "okta_group_rule"
+	groupRulePreview       = "okta_group_rule_preview"
 	identityProvider       = "okta_identity_provider"
 	idpResource            = "okta_idp_oidc"
 	idpSaml                = "okta_idp_saml"
@@ -44,6 +56,7 @@ const (
 	policySignOn           = "okta_policy_signon"
 	trustedOrigin          = "okta_trusted_origin"
 	userBaseSchema         = "okta_user_base_schema"
+	userFactor             = "okta_user_factor"
 	userSchema             = "okta_user_schema"
 )
 
@@ -108,15 +121,22 @@ func Provider() terraform.ResourceProvider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			appAutoLogin:           resourceAppAutoLogin(),
+			appBasicAuth:           resourceAppBasicAuth(),
 			appBookmark:            resourceAppBookmark(),
+			appGroupPush:           resourceAppGroupPush(),
 			appOAuth:               resourceAppOAuth(),
+			appOAuthApiScope:       resourceAppOAuthApiScope(),
+			appOAuthPostLogoutUri:  resourceAppOAuthPostLogoutRedirectUri(),
 			appOAuthRedirectUri:    resourceAppOAuthRedirectUri(),
+			appProvisioning:        resourceAppProvisioning(),
 			appSaml:                resourceAppSaml(),
 			appSecurePasswordStore: resourceAppSecurePasswordStore(),
 			appSwa:                 resourceAppSwa(),
 			appThreeField:          resourceAppThreeField(),
+			appWsFederation:        resourceAppWsFederation(),
 			authServer:             resourceAuthServer(),
 			authServerClaim:        resourceAuthServerClaim(),
+			authServerDefault:      resourceAuthServerDefault(),
 			authServerPolicy:       resourceAuthServerPolicy(),
 			authServerPolicyRule:   resourceAuthServerPolicyRule(),
 			authServerScope:        resourceAuthServerScope(),
@@ -137,6 +157,7 @@ func Provider() terraform.ResourceProvider {
 			policyRuleSignOn:       resourcePolicySignonRule(),
 			policySignOn:           resourcePolicySignon(),
 			trustedOrigin:          resourceTrustedOrigin(),
+			userFactor:             resourceUserFactor(),
 			userSchema:             resourceUserSchema(),
 
 			// Below resources will be deprecated, soon to be removed
@@ -165,14 +186,21 @@ func Provider() terraform.ResourceProvider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"okta_app":              dataSourceApp(),
+			"okta_apps":             dataSourceApps(),
+			appSamlMetadata:         dataSourceAppSamlMetadata(),
 			"okta_default_policies": deprecatedPolicies,
 			"okta_default_policy":   dataSourceDefaultPolicies(),
 			"okta_everyone_group":   dataSourceEveryoneGroup(),
 			"okta_group":            dataSourceGroup(),
+			groupRulePreview:        dataSourceGroupRulePreview(),
 			"okta_policy":           dataSourcePolicy(),
 			"okta_user":             dataSourceUser(),
 			"okta_users":            dataSourceUsers(),
 			authServer:              dataSourceAuthServer(),
+			authServerClaims:        dataSourceAuthServerClaims(),
+			authServerMetadata:      dataSourceAuthServerMetadata(),
+			authServerPolicy:        dataSourceAuthServerPolicy(),
+			authServerScopes:        dataSourceAuthServerScopes(),
 		},
 
 		ConfigureFunc: providerConfigure,
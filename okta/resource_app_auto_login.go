@@ -2,7 +2,6 @@ package okta
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/okta/okta-sdk-golang/okta"
 	"github.com/okta/okta-sdk-golang/okta/query"
 )
@@ -17,6 +16,7 @@ func resourceAppAutoLogin() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateSchemeCreds,
 
 		Schema: buildAppSwaSchema(map[string]*schema.Schema{
 			"preconfigured_app": &schema.Schema{
@@ -36,38 +36,6 @@ func resourceAppAutoLogin() *schema.Resource {
 				Description:  "Post login redirect URL",
 				ValidateFunc: validateIsURL,
 			},
-			"credentials_scheme": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ValidateFunc: validation.StringInSlice(
-					[]string{
-						"EDIT_USERNAME_AND_PASSWORD",
-						"ADMIN_SETS_CREDENTIALS",
-						"EDIT_PASSWORD_ONLY",
-						"EXTERNAL_PASSWORD_SYNC",
-						"SHARED_USERNAME_AND_PASSWORD",
-					},
-					false,
-				),
-				Default:     "EDIT_USERNAME_AND_PASSWORD",
-				Description: "Application credentials scheme",
-			},
-			"reveal_password": &schema.Schema{
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Allow user to reveal password",
-			},
-			"shared_username": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Shared username, required for certain schemes.",
-			},
-			"shared_password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Shared password, required for certain schemes.",
-			},
 		}),
 	}
 }
@@ -85,12 +53,20 @@ func resourceAppAutoLoginCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(app.Id)
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppAutoLoginRead(d, m)
 }
 
 func resourceAppAutoLoginRead(d *schema.ResourceData, m interface{}) error {
 	app := okta.NewAutoLoginApplication()
-	err := fetchApp(d, m, app)
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -101,17 +77,12 @@ func resourceAppAutoLoginRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("sign_on_redirect_url", app.Settings.SignOn.RedirectUrl)
 	}
 
-	d.Set("credentials_scheme", app.Credentials.Scheme)
-	d.Set("reveal_password", app.Credentials.RevealPassword)
-
-	// We can sync shared username but not password from upstream
-	d.Set("shared_username", app.Credentials.UserName)
-
-	d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
+	setSchemeCreds(d, app.Credentials)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
-	return nil
+	syncAppNotesAndLogo(d, raw)
+
+	return syncGroupsAndUsers(app.Id, d, m)
 }
 
 func resourceAppAutoLoginUpdate(d *schema.ResourceData, m interface{}) error {
@@ -130,6 +101,14 @@ func resourceAppAutoLoginUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppAutoLoginRead(d, m)
 }
 
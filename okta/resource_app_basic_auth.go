@@ -0,0 +1,143 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
+func resourceAppBasicAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppBasicAuthCreate,
+		Read:   resourceAppBasicAuthRead,
+		Update: resourceAppBasicAuthUpdate,
+		Delete: resourceAppBasicAuthDelete,
+		Exists: resourceAppExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateSchemeCreds,
+
+		// For those familiar with Terraform schemas be sure to check the base application schema and/or
+		// the examples in the documentation
+		Schema: buildAppSwaSchemaWithComputedScheme(map[string]*schema.Schema{
+			"url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Login URL",
+				ValidateFunc: validateIsURL,
+			},
+			"auth_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL of the page protected by basic authentication",
+				ValidateFunc: validateIsURL,
+			},
+		}),
+	}
+}
+
+func resourceAppBasicAuthCreate(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppBasicAuth(d, m)
+	activate := d.Get("status").(string) == "ACTIVE"
+	params := &query.Params{Activate: &activate}
+	_, _, err := client.Application.CreateApplication(app, params)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(app.Id)
+
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
+	return resourceAppBasicAuthRead(d, m)
+}
+
+func resourceAppBasicAuthRead(d *schema.ResourceData, m interface{}) error {
+	app := okta.NewBasicAuthApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
+
+	if err != nil {
+		return err
+	}
+
+	if app.Settings != nil && app.Settings.App != nil {
+		d.Set("url", app.Settings.App.Url)
+		d.Set("auth_url", app.Settings.App.AuthURL)
+	}
+	setSchemeCreds(d, app.Credentials)
+	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+
+	syncAppNotesAndLogo(d, raw)
+
+	return syncGroupsAndUsers(app.Id, d, m)
+}
+
+func resourceAppBasicAuthUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppBasicAuth(d, m)
+	_, _, err := client.Application.UpdateApplication(d.Id(), app)
+
+	if err != nil {
+		return err
+	}
+
+	desiredStatus := d.Get("status").(string)
+	err = setAppStatus(d, client, app.Status, desiredStatus)
+
+	if err != nil {
+		return err
+	}
+
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
+	return resourceAppBasicAuthRead(d, m)
+}
+
+func resourceAppBasicAuthDelete(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	_, err := client.Application.DeactivateApplication(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Application.DeleteApplication(d.Id())
+
+	return err
+}
+
+func buildAppBasicAuth(d *schema.ResourceData, m interface{}) *okta.BasicAuthApplication {
+	// Abstracts away name and SignOnMode which are constant for this app type.
+	app := okta.NewBasicAuthApplication()
+	app.Label = d.Get("label").(string)
+	a11ySelfService := d.Get("accessibility_self_service").(bool)
+
+	app.Settings = &okta.BasicApplicationSettings{
+		App: &okta.BasicApplicationSettingsApplication{
+			AuthURL: d.Get("auth_url").(string),
+			Url:     d.Get("url").(string),
+		},
+	}
+	app.Accessibility = &okta.ApplicationAccessibility{
+		SelfService:      &a11ySelfService,
+		ErrorRedirectUrl: d.Get("accessibility_error_redirect_url").(string),
+	}
+	app.Visibility = buildVisibility(d)
+	app.Credentials = buildSchemeCreds(d)
+
+	return app
+}
@@ -54,12 +54,16 @@ func resourceAppBookmarkCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppBookmarkRead(d, m)
 }
 
 func resourceAppBookmarkRead(d *schema.ResourceData, m interface{}) error {
 	app := okta.NewBookmarkApplication()
-	err := fetchApp(d, m, app)
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -75,6 +79,8 @@ func resourceAppBookmarkRead(d *schema.ResourceData, m interface{}) error {
 
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
+	syncAppNotesAndLogo(d, raw)
+
 	return nil
 }
 
@@ -97,6 +103,10 @@ func resourceAppBookmarkUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppBookmarkRead(d, m)
 }
 
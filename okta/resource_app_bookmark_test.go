@@ -44,3 +44,45 @@ func TestAccOktaappBookmarklication(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaAppBookmarkDisplay(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appBookmark)
+	config := mgr.GetFixtures("display.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("display_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appBookmark)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appBookmark, createDoesAppExist(okta.NewBookmarkApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewBookmarkApplication())),
+					resource.TestCheckResourceAttr(resourceName, "logo", hashFile("../examples/okta_app_bookmark/logo.png")),
+					resource.TestCheckResourceAttrSet(resourceName, "logo_url"),
+					resource.TestCheckResourceAttr(resourceName, "app_links_json", `{"login":true}`),
+					resource.TestCheckResourceAttr(resourceName, "admin_note", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "enduser_note", "Reach out to the help desk for access"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logo", hashFile("../examples/okta_app_bookmark/logo_updated.png")),
+					resource.TestCheckResourceAttr(resourceName, "app_links_json", `{"login":false}`),
+					resource.TestCheckResourceAttr(resourceName, "admin_note", "Managed by Terraform, do not edit"),
+					resource.TestCheckResourceAttr(resourceName, "enduser_note", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"logo"},
+			},
+		},
+	})
+}
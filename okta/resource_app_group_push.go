@@ -0,0 +1,132 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Pushes an Okta group to an app with provisioning enabled. The target group is either created by name or an existing
+// group of the app is linked by ID.
+func resourceAppGroupPush() *schema.Resource {
+	return &schema.Resource{
+		Create:   withParentLock("app_id", resourceAppGroupPushCreate),
+		Read:     resourceAppGroupPushRead,
+		Update:   withParentLock("app_id", resourceAppGroupPushUpdate),
+		Delete:   withParentLock("app_id", resourceAppGroupPushDelete),
+		Importer: createCustomNestedResourceImporter([]string{"app_id", "id"}, "Expecting the following format: <app_id>/<mapping_id>"),
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app to push the group to.",
+			},
+			"source_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Okta group to push.",
+			},
+			"target_group_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"target_group_id"},
+				// Okta does not return it, imported mappings only know the ID of the group they push to
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != "" && d.Get("target_group_id").(string) != ""
+				},
+				Description: "Name of the group to create in the app.",
+			},
+			"target_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of an existing group in the app to link, computed when target_group_name is set.",
+			},
+			"status": statusSchema,
+			"delete_target_group": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the target group is deleted from the app along with the mapping.",
+			},
+		},
+	}
+}
+
+func resourceAppGroupPushCreate(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	mapping := GroupPushMapping{
+		SourceGroupId:   d.Get("source_group_id").(string),
+		Status:          d.Get("status").(string),
+		TargetGroupId:   d.Get("target_group_id").(string),
+		TargetGroupName: d.Get("target_group_name").(string),
+	}
+	// target_group_id is computed so this cannot be validated at plan time
+	if mapping.TargetGroupId == "" && mapping.TargetGroupName == "" {
+		return fmt.Errorf("one of target_group_name or target_group_id is required")
+	}
+	created, _, err := getSupplementFromMetadata(m).CreateGroupPushMapping(appId, mapping)
+	if err != nil {
+		return fmt.Errorf("failed to push group %s to app %s: %v", mapping.SourceGroupId, appId, err)
+	}
+	d.SetId(created.Id)
+
+	return resourceAppGroupPushRead(d, m)
+}
+
+func resourceAppGroupPushRead(d *schema.ResourceData, m interface{}) error {
+	mapping, resp, err := getSupplementFromMetadata(m).GetGroupPushMapping(d.Get("app_id").(string), d.Id())
+	if resp != nil && is404(resp.StatusCode) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return responseErr(resp, err)
+	}
+
+	d.Set("source_group_id", mapping.SourceGroupId)
+	d.Set("target_group_id", mapping.TargetGroupId)
+	// A failed push has the ERROR status, the resulting diff reactivates the mapping which retries the push
+	if mapping.Status == "ERROR" {
+		log.Printf("[WARN] Push of group %s to app %s failed: %s", mapping.SourceGroupId, d.Get("app_id").(string), mapping.ErrorSummary)
+	}
+	d.Set("status", mapping.Status)
+
+	return nil
+}
+
+func resourceAppGroupPushUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("status") {
+		_, _, err := getSupplementFromMetadata(m).UpdateGroupPushMappingStatus(d.Get("app_id").(string), d.Id(), d.Get("status").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAppGroupPushRead(d, m)
+}
+
+func resourceAppGroupPushDelete(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	client := getSupplementFromMetadata(m)
+
+	if d.Get("status").(string) != "INACTIVE" {
+		_, resp, err := client.UpdateGroupPushMappingStatus(appId, d.Id(), "INACTIVE")
+		if resp != nil && is404(resp.StatusCode) {
+			return nil
+		}
+		if err != nil {
+			return responseErr(resp, err)
+		}
+	}
+
+	resp, err := client.DeleteGroupPushMapping(appId, d.Id(), d.Get("delete_target_group").(bool))
+
+	return suppressErrorOn404(resp, err)
+}
@@ -0,0 +1,64 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOktaAppGroupPush(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appGroupPush)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appGroupPush)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: checkGroupPushDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_group_id", "okta_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_group_id"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check:  resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       groupPushImportId(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_target_group", "target_group_name"},
+			},
+		},
+	})
+}
+
+func checkGroupPushDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != appGroupPush {
+			continue
+		}
+
+		_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetGroupPushMapping(rs.Primary.Attributes["app_id"], rs.Primary.ID)
+		if resp != nil && is404(resp.StatusCode) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("group push mapping %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
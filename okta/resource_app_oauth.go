@@ -1,6 +1,10 @@
 package okta
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/okta/okta-sdk-golang/okta"
@@ -73,25 +77,29 @@ func resourceAppOAuth() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAppOAuthCreate,
 		Read:   resourceAppOAuthRead,
-		Update: resourceAppOAuthUpdate,
-		Delete: resourceAppOAuthDelete,
+		// Child resources such as okta_app_oauth_redirect_uri modify the application as well
+		Update: withParentLock("id", resourceAppOAuthUpdate),
+		Delete: withParentLock("id", resourceAppOAuthDelete),
 		Exists: resourceAppOAuthExists,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
-			// Force new if omit_secret goes from true to false
-			if d.Id() != "" {
-				old, new := d.GetChange("omit_secret")
-				if old.(bool) == true && new.(bool) == false {
-					return d.ForceNew("omit_secret")
+			// A new secret is generated when it is rotated or when omit_secret goes from true to false
+			oldOmit, newOmit := d.GetChange("omit_secret")
+			if d.Id() != "" && (d.HasChange("client_secret_rotation_trigger") || oldOmit.(bool) && !newOmit.(bool)) {
+				if err := d.SetNewComputed("client_secret"); err != nil {
+					return err
 				}
 			}
-			return nil
+			if err := validateAppOAuthLogin(d); err != nil {
+				return err
+			}
+			return validateAppOAuthJwks(d)
 		},
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
-		Schema: buildAppSchema(map[string]*schema.Schema{
+		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
 			"type": &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringInSlice([]string{"web", "native", "browser", "service"}, false),
@@ -107,9 +115,45 @@ func resourceAppOAuth() *schema.Resource {
 			"omit_secret": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
-				// No ForceNew to avoid recreating when going from false => true
-				Description: "This tells the provider not to persist the application's secret to state. If this is ever changes from true => false your app will be recreated.",
+				// No ForceNew, the client ID must survive going either way
+				Description: "This tells the provider not to persist the application's secret to state. If this is ever changed from true => false a new secret is generated.",
+				Default:     false,
+			},
+			"client_secret_rotation_trigger": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, any change to it generates a new client secret without replacing the application.",
+			},
+			"keep_previous_client_secret": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Default:     false,
+				Description: "Keep the previous secret active after a rotation so consumers can switch over. Okta allows two secrets, older ones are removed on the next rotation.",
+			},
+			"client_secrets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Secrets of the application, without their values.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_hash": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			"client_secret": &schema.Schema{
 				Type:        schema.TypeString,
@@ -121,12 +165,76 @@ func resourceAppOAuth() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				ValidateFunc: validation.StringInSlice(
-					[]string{"none", "client_secret_post", "client_secret_basic", "client_secret_jwt"},
+					[]string{"none", "client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt"},
 					false,
 				),
 				Default:     "client_secret_basic",
 				Description: "Requested authentication method for the token endpoint.",
 			},
+			"jwks": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Public keys of the client, required when token_endpoint_auth_method is private_key_jwt.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"kty": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"RSA", "EC"}, false),
+						},
+						"e": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "RSA exponent.",
+						},
+						"n": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "RSA modulus.",
+						},
+						"crv": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"P-256", "P-384", "P-521"}, false),
+							Description:  "EC curve.",
+						},
+						"x": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "EC x coordinate.",
+						},
+						"y": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "EC y coordinate.",
+						},
+					},
+				},
+			},
+			"pkce_required": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Require Proof Key for Code Exchange (PKCE) for additional verification.",
+			},
+			"refresh_token_rotation": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STATIC",
+				ValidateFunc: validation.StringInSlice([]string{"STATIC", "ROTATE"}, false),
+				Description:  "Refresh token rotation behavior, only applies when the refresh_token grant type is enabled.",
+			},
+			"refresh_token_leeway": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(0, 60),
+				Description:  "Grace period in seconds during which a rotated refresh token can still be used.",
+			},
 			"auto_key_rotation": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -148,6 +256,19 @@ func resourceAppOAuth() *schema.Resource {
 				Optional:    true,
 				Description: "URI that initiates login.",
 			},
+			"login_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DISABLED", "SPEC", "OKTA"}, false),
+				Description:  "How the app is launched from the dashboard, SPEC redirects to login_uri and OKTA sends an authorization request.",
+			},
+			"login_scopes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Scopes requested when the app is launched from the dashboard, only used with the OKTA login mode.",
+			},
 			"redirect_uris": &schema.Schema{
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -210,13 +331,68 @@ func resourceAppOAuth() *schema.Resource {
 }
 
 func resourceAppOAuthExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	app := okta.NewOpenIdConnectApplication()
+	app := newOAuthApplication()
 	err := fetchApp(d, m, app)
 
 	// Not sure if a non-nil app with an empty ID is possible but checking to avoid false positives.
 	return app != nil && app.Id != "", err
 }
 
+// Okta only reports a generic invalid key error, validate the keys locally so the culprit is obvious.
+func validateAppOAuthJwks(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("jwks") {
+		return nil
+	}
+
+	keys := d.Get("jwks").([]interface{})
+	if d.Get("token_endpoint_auth_method").(string) == "private_key_jwt" && len(keys) == 0 {
+		return fmt.Errorf("jwks is required when token_endpoint_auth_method is private_key_jwt")
+	}
+
+	kids := map[string]bool{}
+	for i, raw := range keys {
+		key := raw.(map[string]interface{})
+		kid := key["kid"].(string)
+		if kids[kid] {
+			return fmt.Errorf("jwks.%d: kid %s is used by more than one key", i, kid)
+		}
+		kids[kid] = true
+
+		var required, forbidden []string
+		if key["kty"].(string) == "RSA" {
+			required, forbidden = []string{"e", "n"}, []string{"crv", "x", "y"}
+		} else {
+			required, forbidden = []string{"crv", "x", "y"}, []string{"e", "n"}
+		}
+
+		for _, field := range required {
+			if key[field].(string) == "" {
+				return fmt.Errorf("jwks.%d: %s is required for %s keys", i, field, key["kty"])
+			}
+		}
+		for _, field := range forbidden {
+			if key[field].(string) != "" {
+				return fmt.Errorf("jwks.%d: %s cannot be set for %s keys", i, field, key["kty"])
+			}
+		}
+	}
+
+	return nil
+}
+
+// Launching the app from the dashboard goes through login_uri
+func validateAppOAuthLogin(d *schema.ResourceDiff) error {
+	mode := d.Get("login_mode").(string)
+	if mode != "" && mode != "DISABLED" && d.Get("login_uri").(string) == "" && d.NewValueKnown("login_uri") {
+		return fmt.Errorf("login_uri is required when login_mode is %s", mode)
+	}
+	if mode != "OKTA" && d.Get("login_scopes").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("login_scopes can only be set when login_mode is OKTA")
+	}
+
+	return nil
+}
+
 func validateGrantTypes(d *schema.ResourceData) error {
 	grantTypeList := convertInterfaceToStringSet(d.Get("grant_types"))
 	appType := d.Get("type").(string)
@@ -253,12 +429,16 @@ func resourceAppOAuthCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppOAuthRead(d, m)
 }
 
 func resourceAppOAuthRead(d *schema.ResourceData, m interface{}) error {
-	app := okta.NewOpenIdConnectApplication()
-	err := fetchApp(d, m, app)
+	app := newOAuthApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -279,11 +459,29 @@ func resourceAppOAuthRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("tos_uri", app.Settings.OauthClient.TosUri)
 	d.Set("policy_uri", app.Settings.OauthClient.PolicyUri)
 	d.Set("login_uri", app.Settings.OauthClient.InitiateLoginUri)
+	if login := app.Settings.OauthClient.IdpInitiatedLogin; login != nil {
+		d.Set("login_mode", login.Mode)
+		d.Set("login_scopes", convertStringSetToInterface(login.DefaultScope))
+	}
+	if app.Visibility != nil {
+		setVisibility(d, app.Visibility)
+	}
+	syncAppNotesAndLogo(d, raw)
 
 	if app.Settings.OauthClient.IssuerMode != "" {
 		d.Set("issuer_mode", app.Settings.OauthClient.IssuerMode)
 	}
 
+	if app.Credentials.OauthClient.PkceRequired != nil {
+		d.Set("pkce_required", *app.Credentials.OauthClient.PkceRequired)
+	}
+
+	// Only returned when the refresh_token grant type is enabled
+	if rt := app.Settings.OauthClient.RefreshToken; rt != nil {
+		d.Set("refresh_token_rotation", rt.RotationType)
+		d.Set("refresh_token_leeway", rt.Leeway)
+	}
+
 	// If this is ever changed omit it.
 	if d.Get("omit_secret").(bool) {
 		d.Set("client_secret", "")
@@ -292,11 +490,16 @@ func resourceAppOAuthRead(d *schema.ResourceData, m interface{}) error {
 	if err = syncGroupsAndUsers(app.Id, d, m); err != nil {
 		return err
 	}
+
+	if err = syncAppOAuthSecrets(d, m); err != nil {
+		return err
+	}
 	aggMap := map[string]interface{}{
 		"redirect_uris":             convertStringSetToInterface(app.Settings.OauthClient.RedirectUris),
 		"response_types":            convertStringSetToInterface(app.Settings.OauthClient.ResponseTypes),
 		"grant_types":               convertStringSetToInterface(app.Settings.OauthClient.GrantTypes),
 		"post_logout_redirect_uris": convertStringSetToInterface(app.Settings.OauthClient.PostLogoutRedirectUris),
+		"jwks":                      flattenAppOAuthJwks(app.Settings.OauthClient.Jwks),
 	}
 
 	return setNonPrimitives(d, aggMap)
@@ -322,6 +525,17 @@ func resourceAppOAuthUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
+	oldOmit, newOmit := d.GetChange("omit_secret")
+	if d.HasChange("client_secret_rotation_trigger") || (oldOmit.(bool) && !newOmit.(bool) && usesClientSecret(d)) {
+		if err := rotateAppOAuthSecret(d, m); err != nil {
+			return err
+		}
+	}
+
 	return resourceAppOAuthRead(d, m)
 }
 
@@ -339,9 +553,85 @@ func resourceAppOAuthDelete(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
-func buildAppOAuth(d *schema.ResourceData, m interface{}) *okta.OpenIdConnectApplication {
+func usesClientSecret(d *schema.ResourceData) bool {
+	return strings.HasPrefix(d.Get("token_endpoint_auth_method").(string), "client_secret")
+}
+
+// Generates a new secret, the client ID does not change. Okta allows two secrets so the oldest are removed to make
+// room, the previous one is then removed as well unless keep_previous_client_secret is set.
+func rotateAppOAuthSecret(d *schema.ResourceData, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+	secrets, _, err := client.ListAppOAuthSecrets(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to list client secrets of application %s: %v", d.Id(), err)
+	}
+
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Created < secrets[j].Created })
+	for len(secrets) > 1 {
+		if err := removeAppOAuthSecret(d.Id(), secrets[0], m); err != nil {
+			return err
+		}
+		secrets = secrets[1:]
+	}
+
+	secret, _, err := client.CreateAppOAuthSecret(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to generate a client secret for application %s: %v", d.Id(), err)
+	}
+
+	if !d.Get("keep_previous_client_secret").(bool) {
+		for _, previous := range secrets {
+			if err := removeAppOAuthSecret(d.Id(), previous, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.Get("omit_secret").(bool) {
+		d.Set("client_secret", secret.ClientSecret)
+	}
+
+	return nil
+}
+
+func removeAppOAuthSecret(appId string, secret *OAuthClientSecret, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+	if secret.Status == "ACTIVE" {
+		if _, err := client.DeactivateAppOAuthSecret(appId, secret.Id); err != nil {
+			return fmt.Errorf("failed to deactivate client secret %s of application %s: %v", secret.Id, appId, err)
+		}
+	}
+
+	if _, err := client.DeleteAppOAuthSecret(appId, secret.Id); err != nil {
+		return fmt.Errorf("failed to delete client secret %s of application %s: %v", secret.Id, appId, err)
+	}
+
+	return nil
+}
+
+func syncAppOAuthSecrets(d *schema.ResourceData, m interface{}) error {
+	secrets, resp, err := getSupplementFromMetadata(m).ListAppOAuthSecrets(d.Id())
+	// Orgs without multiple secret support do not have this endpoint
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+
+	arr := make([]map[string]interface{}, len(secrets))
+	for i, secret := range secrets {
+		arr[i] = map[string]interface{}{
+			"created":     secret.Created,
+			"id":          secret.Id,
+			"secret_hash": secret.SecretHash,
+			"status":      secret.Status,
+		}
+	}
+
+	return d.Set("client_secrets", arr)
+}
+
+func buildAppOAuth(d *schema.ResourceData, m interface{}) *OAuthApplication {
 	// Abstracts away name and SignOnMode which are constant for this app type.
-	app := okta.NewOpenIdConnectApplication()
+	app := newOAuthApplication()
 
 	// Need to a bool pointer, it appears the Okta SDK uses this as a way to avoid false being omitted.
 	keyRotation := d.Get("auto_key_rotation").(bool)
@@ -374,29 +664,94 @@ func buildAppOAuth(d *schema.ResourceData, m interface{}) *okta.OpenIdConnectApp
 	}
 
 	app.Label = d.Get("label").(string)
-	app.Credentials = &okta.OAuthApplicationCredentials{
-		OauthClient: &okta.ApplicationCredentialsOAuthClient{
-			AutoKeyRotation:         &keyRotation,
-			ClientId:                d.Get("client_id").(string),
-			TokenEndpointAuthMethod: d.Get("token_endpoint_auth_method").(string),
-		},
+	app.Credentials.OauthClient.ApplicationCredentialsOAuthClient = &okta.ApplicationCredentialsOAuthClient{
+		AutoKeyRotation:         &keyRotation,
+		ClientId:                d.Get("client_id").(string),
+		TokenEndpointAuthMethod: d.Get("token_endpoint_auth_method").(string),
 	}
-	app.Settings = &okta.OpenIdConnectApplicationSettings{
-		OauthClient: &okta.OpenIdConnectApplicationSettingsClient{
-			ApplicationType:        appType,
-			ClientUri:              d.Get("client_uri").(string),
-			ConsentMethod:          d.Get("consent_method").(string),
-			GrantTypes:             grantTypes,
-			InitiateLoginUri:       d.Get("login_uri").(string),
-			LogoUri:                d.Get("logo_uri").(string),
-			PolicyUri:              d.Get("policy_uri").(string),
-			RedirectUris:           convertInterfaceToStringSetNullable(d.Get("redirect_uris")),
-			PostLogoutRedirectUris: convertInterfaceToStringSetNullable(d.Get("post_logout_redirect_uris")),
-			ResponseTypes:          responseTypes,
-			TosUri:                 d.Get("tos_uri").(string),
-			IssuerMode:             d.Get("issuer_mode").(string),
-		},
+	if pkce, ok := d.GetOkExists("pkce_required"); ok {
+		pkceRequired := pkce.(bool)
+		app.Credentials.OauthClient.PkceRequired = &pkceRequired
+	}
+
+	app.Visibility = buildVisibility(d)
+	app.Settings.OauthClient.Jwks = buildAppOAuthJwks(d)
+	// Apps that do not configure it keep how Okta launches them
+	if mode, ok := d.GetOk("login_mode"); ok {
+		app.Settings.OauthClient.IdpInitiatedLogin = &OAuthIdpInitiatedLogin{
+			DefaultScope: convertInterfaceToStringSet(d.Get("login_scopes")),
+			Mode:         mode.(string),
+		}
+	}
+	// Okta rejects refresh token settings when the grant type is not enabled
+	if contains(grantTypes, refreshToken) {
+		app.Settings.OauthClient.RefreshToken = &OAuthRefreshToken{
+			Leeway:       d.Get("refresh_token_leeway").(int),
+			RotationType: d.Get("refresh_token_rotation").(string),
+		}
+	}
+	app.Settings.OauthClient.OpenIdConnectApplicationSettingsClient = &okta.OpenIdConnectApplicationSettingsClient{
+		ApplicationType:        appType,
+		ClientUri:              d.Get("client_uri").(string),
+		ConsentMethod:          d.Get("consent_method").(string),
+		GrantTypes:             grantTypes,
+		InitiateLoginUri:       d.Get("login_uri").(string),
+		LogoUri:                d.Get("logo_uri").(string),
+		PolicyUri:              d.Get("policy_uri").(string),
+		RedirectUris:           convertInterfaceToStringSetNullable(d.Get("redirect_uris")),
+		PostLogoutRedirectUris: convertInterfaceToStringSetNullable(d.Get("post_logout_redirect_uris")),
+		ResponseTypes:          responseTypes,
+		TosUri:                 d.Get("tos_uri").(string),
+		IssuerMode:             d.Get("issuer_mode").(string),
 	}
 
 	return app
 }
+
+func buildAppOAuthJwks(d *schema.ResourceData) *JSONWebKeySet {
+	raw := d.Get("jwks").([]interface{})
+	if len(raw) == 0 {
+		// Omitting jwks leaves the keys in place, so an empty set is sent when they are removed
+		if d.HasChange("jwks") {
+			return &JSONWebKeySet{Keys: []*JSONWebKey{}}
+		}
+		return nil
+	}
+
+	keys := make([]*JSONWebKey, len(raw))
+	for i, v := range raw {
+		key := v.(map[string]interface{})
+		keys[i] = &JSONWebKey{
+			Crv: key["crv"].(string),
+			E:   key["e"].(string),
+			Kid: key["kid"].(string),
+			Kty: key["kty"].(string),
+			N:   key["n"].(string),
+			X:   key["x"].(string),
+			Y:   key["y"].(string),
+		}
+	}
+
+	return &JSONWebKeySet{Keys: keys}
+}
+
+func flattenAppOAuthJwks(jwks *JSONWebKeySet) []interface{} {
+	if jwks == nil {
+		return nil
+	}
+
+	arr := make([]interface{}, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		arr[i] = map[string]interface{}{
+			"crv": key.Crv,
+			"e":   key.E,
+			"kid": key.Kid,
+			"kty": key.Kty,
+			"n":   key.N,
+			"x":   key.X,
+			"y":   key.Y,
+		}
+	}
+
+	return arr
+}
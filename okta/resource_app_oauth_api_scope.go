@@ -0,0 +1,183 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Grants Okta management API scopes to an OAuth application, the ID of the resource is the ID of the application.
+func resourceAppOAuthApiScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppOAuthApiScopeCreate,
+		Read:   resourceAppOAuthApiScopeRead,
+		Update: resourceAppOAuthApiScopeUpdate,
+		Delete: resourceAppOAuthApiScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("app_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the OAuth application.",
+			},
+			"issuer": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIsURL,
+				Description:  "Issuer of the grants, defaults to the org URL.",
+			},
+			"scopes": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^okta\.[a-zA-Z]+(\.[a-zA-Z]+)+$`), "must be an Okta API scope, such as okta.users.read"),
+				},
+				Description: "Okta API scopes to grant, such as okta.users.read.",
+			},
+		},
+	}
+}
+
+func resourceAppOAuthApiScopeCreate(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	if _, ok := d.GetOk("issuer"); !ok {
+		d.Set("issuer", getBaseUrl(m))
+	}
+
+	if err := grantAppScopes(appId, d.Get("issuer").(string), convertInterfaceToStringSet(d.Get("scopes")), m); err != nil {
+		return err
+	}
+	d.SetId(appId)
+
+	return resourceAppOAuthApiScopeRead(d, m)
+}
+
+func resourceAppOAuthApiScopeRead(d *schema.ResourceData, m interface{}) error {
+	grants, resp, err := getSupplementFromMetadata(m).ListAppGrants(d.Id())
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	issuer := d.Get("issuer").(string)
+	var scopes []string
+	for _, grant := range grants {
+		// Imported resources do not know their issuer yet
+		if issuer == "" {
+			issuer = grant.Issuer
+		}
+		if grant.Issuer == issuer {
+			scopes = append(scopes, grant.ScopeId)
+		}
+	}
+
+	d.Set("app_id", d.Id())
+	d.Set("issuer", issuer)
+
+	return d.Set("scopes", convertStringSetToInterface(scopes))
+}
+
+func resourceAppOAuthApiScopeUpdate(d *schema.ResourceData, m interface{}) error {
+	old, new := d.GetChange("scopes")
+	oldScopes := convertInterfaceToStringSet(old)
+	newScopes := convertInterfaceToStringSet(new)
+
+	var added, removed []string
+	for _, scope := range newScopes {
+		if !contains(oldScopes, scope) {
+			added = append(added, scope)
+		}
+	}
+	for _, scope := range oldScopes {
+		if !contains(newScopes, scope) {
+			removed = append(removed, scope)
+		}
+	}
+
+	if err := grantAppScopes(d.Id(), d.Get("issuer").(string), added, m); err != nil {
+		return err
+	}
+
+	if err := revokeAppScopes(d.Id(), d.Get("issuer").(string), removed, m); err != nil {
+		return err
+	}
+
+	return resourceAppOAuthApiScopeRead(d, m)
+}
+
+func resourceAppOAuthApiScopeDelete(d *schema.ResourceData, m interface{}) error {
+	return revokeAppScopes(d.Id(), d.Get("issuer").(string), convertInterfaceToStringSet(d.Get("scopes")), m)
+}
+
+// Scopes that are already granted are skipped, granting them again fails
+func grantAppScopes(appId, issuer string, scopes []string, m interface{}) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	client := getSupplementFromMetadata(m)
+	grants, resp, err := client.ListAppGrants(appId)
+	if err != nil {
+		return responseErr(resp, err)
+	}
+
+	var granted []string
+	for _, grant := range grants {
+		if grant.Issuer == issuer {
+			granted = append(granted, grant.ScopeId)
+		}
+	}
+
+	for _, scope := range scopes {
+		if contains(granted, scope) {
+			continue
+		}
+		if _, _, err := client.GrantAppScope(appId, AppGrant{Issuer: issuer, ScopeId: scope}); err != nil {
+			return fmt.Errorf("failed to grant %s to application %s: %v", scope, appId, err)
+		}
+	}
+
+	return nil
+}
+
+// Grants are revoked by ID, so look them up by scope
+func revokeAppScopes(appId, issuer string, scopes []string, m interface{}) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	client := getSupplementFromMetadata(m)
+	grants, resp, err := client.ListAppGrants(appId)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		if grant.Issuer != issuer || !contains(scopes, grant.ScopeId) {
+			continue
+		}
+
+		resp, err := client.RevokeAppGrant(appId, grant.Id)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to revoke %s from application %s: %v", grant.ScopeId, appId, err)
+		}
+	}
+
+	return nil
+}
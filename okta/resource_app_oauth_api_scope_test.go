@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+func TestAccOktaAppOAuthApiScope(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthApiScope)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthApiScope)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", fmt.Sprintf("%s.test", appOAuth), "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// A scope granted outside of Terraform is adopted instead of failing to be granted twice
+func TestAccOktaAppOAuthApiScopeAlreadyGranted(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthApiScope)
+	appConfig := mgr.GetFixtures("app.tf", ri, t)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthApiScope)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: appConfig,
+				Check:  grantAppScopeOutOfBand(fmt.Sprintf("%s.test", appOAuth), "okta.users.read"),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func grantAppScopeOutOfBand(name, scope string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", name)
+		}
+
+		m := testAccProvider.Meta()
+		_, resp, err := getSupplementFromMetadata(m).GrantAppScope(rs.Primary.ID, AppGrant{Issuer: getBaseUrl(m), ScopeId: scope})
+
+		return responseErr(resp, err)
+	}
+}
@@ -0,0 +1,73 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAppOAuthPostLogoutRedirectUri() *schema.Resource {
+	return &schema.Resource{
+		Create: withParentLock("app_id", resourceAppOAuthPostLogoutRedirectUriCreate),
+		Read:   resourceAppOAuthPostLogoutRedirectUriRead,
+		Update: withParentLock("app_id", resourceAppOAuthPostLogoutRedirectUriUpdate),
+		Delete: withParentLock("app_id", resourceAppOAuthPostLogoutRedirectUriDelete),
+		Exists: resourceAppOAuthPostLogoutRedirectUriExists,
+		// The id for this is the uri
+		Importer: createCustomNestedResourceImporter([]string{"app_id", "id"}, "Expecting the following format: <app_id>/<uri>"),
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Required: true,
+				Type:     schema.TypeString,
+				ForceNew: true,
+			},
+			"uri": &schema.Schema{
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "Post logout redirect URI to append to Okta OIDC application.",
+			},
+		},
+	}
+}
+
+func resourceAppOAuthPostLogoutRedirectUriExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	app := newOAuthApplication()
+	err := fetchAppById(d.Get("app_id").(string), m, app)
+	return err == nil && app.Id != "" && contains(app.Settings.OauthClient.PostLogoutRedirectUris, d.Id()), err
+}
+
+func resourceAppOAuthPostLogoutRedirectUriCreate(d *schema.ResourceData, m interface{}) error {
+	uri := d.Get("uri").(string)
+	err := updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.PostLogoutRedirectUris = append(remove(c.PostLogoutRedirectUris, uri), uri)
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(uri)
+
+	return resourceAppOAuthPostLogoutRedirectUriRead(d, m)
+}
+
+// read does nothing due to the nature of this resource
+func resourceAppOAuthPostLogoutRedirectUriRead(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceAppOAuthPostLogoutRedirectUriUpdate(d *schema.ResourceData, m interface{}) error {
+	old, new := d.GetChange("uri")
+	err := updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.PostLogoutRedirectUris = append(remove(c.PostLogoutRedirectUris, old.(string)), new.(string))
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(new.(string))
+
+	return resourceAppOAuthPostLogoutRedirectUriRead(d, m)
+}
+
+func resourceAppOAuthPostLogoutRedirectUriDelete(d *schema.ResourceData, m interface{}) error {
+	return updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.PostLogoutRedirectUris = remove(c.PostLogoutRedirectUris, d.Id())
+	})
+}
@@ -0,0 +1,62 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func createPostLogoutRedirectUriExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		app := newOAuthApplication()
+		if err := fetchAppById(rs.Primary.Attributes["app_id"], testAccProvider.Meta(), app); err != nil {
+			return err
+		}
+
+		if !contains(app.Settings.OauthClient.PostLogoutRedirectUris, rs.Primary.ID) {
+			return fmt.Errorf("post logout redirect uri %s not found on application", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccOktaAppOAuthPostLogoutRedirectCrud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthPostLogoutUri)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthPostLogoutUri)
+	otherResourceName := fmt.Sprintf("%s.test2", appOAuthPostLogoutUri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					createPostLogoutRedirectUriExists(resourceName),
+					createPostLogoutRedirectUriExists(otherResourceName),
+					resource.TestCheckResourceAttr(resourceName, "uri", "http://google.com"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					createPostLogoutRedirectUriExists(resourceName),
+					createPostLogoutRedirectUriExists(otherResourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", "http://google-updated.com"),
+				),
+			},
+		},
+	})
+}
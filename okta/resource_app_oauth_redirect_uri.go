@@ -2,15 +2,14 @@ package okta
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/okta/okta-sdk-golang/okta"
 )
 
 func resourceAppOAuthRedirectUri() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAppOAuthRedirectUriCreate,
+		Create: withParentLock("app_id", resourceAppOAuthRedirectUriCreate),
 		Read:   resourceAppOAuthRedirectUriRead,
-		Update: resourceAppOAuthRedirectUriUpdate,
-		Delete: resourceAppOAuthRedirectUriDelete,
+		Update: withParentLock("app_id", resourceAppOAuthRedirectUriUpdate),
+		Delete: withParentLock("app_id", resourceAppOAuthRedirectUriDelete),
 		Exists: resourceAppOAuthRedirectUriExists,
 		// The id for this is the uri
 		Importer: createCustomNestedResourceImporter([]string{"app_id", "id"}, "Expecting the following format: <app_id>/<uri>"),
@@ -31,16 +30,20 @@ func resourceAppOAuthRedirectUri() *schema.Resource {
 }
 
 func resourceAppOAuthRedirectUriExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	app := okta.NewOpenIdConnectApplication()
+	app := newOAuthApplication()
 	err := fetchAppById(d.Get("app_id").(string), m, app)
 	return err == nil && app.Id != "" && contains(app.Settings.OauthClient.RedirectUris, d.Id()), err
 }
 
 func resourceAppOAuthRedirectUriCreate(d *schema.ResourceData, m interface{}) error {
-	if err := appendRedirectUri(d, m); err != nil {
+	uri := d.Get("uri").(string)
+	err := updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.RedirectUris = append(remove(c.RedirectUris, uri), uri)
+	})
+	if err != nil {
 		return err
 	}
-	d.SetId(d.Get("uri").(string))
+	d.SetId(uri)
 
 	return resourceAppOAuthRedirectUriRead(d, m)
 }
@@ -51,35 +54,33 @@ func resourceAppOAuthRedirectUriRead(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceAppOAuthRedirectUriUpdate(d *schema.ResourceData, m interface{}) error {
-	if err := appendRedirectUri(d, m); err != nil {
+	old, new := d.GetChange("uri")
+	err := updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.RedirectUris = append(remove(c.RedirectUris, old.(string)), new.(string))
+	})
+	if err != nil {
 		return err
 	}
 	// Normally not advisable, but ForceNew generated unnecessary calls
-	d.SetId(d.Get("uri").(string))
+	d.SetId(new.(string))
 
 	return resourceAppOAuthRedirectUriRead(d, m)
 }
 
 func resourceAppOAuthRedirectUriDelete(d *schema.ResourceData, m interface{}) error {
-	appId := d.Get("app_id").(string)
-	app := okta.NewOpenIdConnectApplication()
-	// Should never hit a 404 due to exists function
-	if err := fetchAppById(appId, m, app); err != nil {
-		return err
-	}
-	app.Settings.OauthClient.RedirectUris = remove(app.Settings.OauthClient.RedirectUris, d.Id())
-
-	return updateAppById(appId, m, app)
+	return updateAppOAuthUris(d.Get("app_id").(string), m, func(c *OAuthApplicationSettingsClient) {
+		c.RedirectUris = remove(c.RedirectUris, d.Id())
+	})
 }
 
-func appendRedirectUri(d *schema.ResourceData, m interface{}) error {
-	appId := d.Get("app_id").(string)
-	app := okta.NewOpenIdConnectApplication()
+// Read-modify-write of the application, callers must hold the lock of the application.
+func updateAppOAuthUris(appId string, m interface{}, update func(*OAuthApplicationSettingsClient)) error {
+	app := newOAuthApplication()
 	// Should never hit a 404 due to exists function
 	if err := fetchAppById(appId, m, app); err != nil {
 		return err
 	}
-	uri := d.Get("uri").(string)
-	app.Settings.OauthClient.RedirectUris = append(app.Settings.OauthClient.RedirectUris, uri)
+	update(app.Settings.OauthClient)
+
 	return updateAppById(appId, m, app)
 }
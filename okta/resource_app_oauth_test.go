@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/okta/okta-sdk-golang/okta"
 	"github.com/okta/okta-sdk-golang/okta/query"
 )
@@ -144,7 +145,10 @@ func TestAccOktaAppOAuthlicationUserGroups(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "login_uri", "http://test.com"),
+					resource.TestCheckResourceAttr(resourceName, "login_mode", "OKTA"),
+					resource.TestCheckResourceAttr(resourceName, "login_scopes.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "post_logout_redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_note", "Managed by Terraform"),
 				),
 			},
 			{
@@ -161,6 +165,151 @@ func TestAccOktaAppOAuthlicationUserGroups(t *testing.T) {
 	})
 }
 
+// Tests private_key_jwt client authentication, PKCE and refresh token rotation
+func TestAccOktaAppOAuthlicationJwksPkce(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("private_key_jwt.tf", ri, t)
+	removedConfig := mgr.GetFixtures("private_key_jwt_removed.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("pkce_refresh_rotation.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "token_endpoint_auth_method", "private_key_jwt"),
+					resource.TestCheckResourceAttr(resourceName, "jwks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jwks.0.kid", "SIGNING_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "jwks.0.e", "AQAB"),
+				),
+			},
+			{
+				// Removing the keys in place has to clear them upstream
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_endpoint_auth_method", "client_secret_basic"),
+					resource.TestCheckResourceAttr(resourceName, "jwks.#", "0"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttr(resourceName, "type", "native"),
+					resource.TestCheckResourceAttr(resourceName, "pkce_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_rotation", "ROTATE"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_leeway", "60"),
+					resource.TestCheckResourceAttr(resourceName, "jwks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Tests duplicate key IDs and missing key components are caught at plan time
+func TestAccOktaAppOAuthlicationBadJwks(t *testing.T) {
+	ri := acctest.RandInt()
+	name := buildResourceName(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  label                      = "%s"
+  type                       = "service"
+  token_endpoint_auth_method = "private_key_jwt"
+
+  jwks {
+    kid = "dup"
+    kty = "RSA"
+    e   = "AQAB"
+    n   = "xyz"
+  }
+
+  jwks {
+    kid = "dup"
+    kty = "RSA"
+    e   = "AQAB"
+    n   = "xyz"
+  }
+}`, appOAuth, name),
+				ExpectError: regexp.MustCompile(`kid dup is used by more than one key`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  label                      = "%s"
+  type                       = "service"
+  token_endpoint_auth_method = "private_key_jwt"
+
+  jwks {
+    kid = "ec"
+    kty = "EC"
+    crv = "P-256"
+    x   = "xyz"
+  }
+}`, appOAuth, name),
+				ExpectError: regexp.MustCompile(`y is required for EC keys`),
+			},
+		},
+	})
+}
+
+// Tests rotating the client secret keeps the client ID
+func TestAccOktaAppOAuthlicationSecretRotation(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuth)
+	config := mgr.GetFixtures("secret_rotation.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("secret_rotation_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuth)
+	var clientId, clientSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						clientId, clientSecret = attrs["client_id"], attrs["client_secret"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_secrets.#", "2"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["client_id"] != clientId {
+							return fmt.Errorf("client_id changed from %s to %s", clientId, attrs["client_id"])
+						}
+						if attrs["client_secret"] == "" || attrs["client_secret"] == clientSecret {
+							return fmt.Errorf("client_secret was not rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Tests properly errors on conditional requirements.
 func TestAccOktaAppOAuthlicationBadGrantTypes(t *testing.T) {
 	ri := acctest.RandInt()
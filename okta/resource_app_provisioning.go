@@ -0,0 +1,337 @@
+package okta
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Provisioning of an application that supports it, typically a SCIM app. This is not your standard resource as the
+// connection exists as soon as the app does, the create function configures it and the delete function disables
+// provisioning. The app ID is the ID of this resource.
+func resourceAppProvisioning() *schema.Resource {
+	return &schema.Resource{
+		Create: withParentLock("app_id", resourceAppProvisioningPut),
+		Read:   resourceAppProvisioningRead,
+		Update: withParentLock("app_id", resourceAppProvisioningPut),
+		Delete: withParentLock("app_id", resourceAppProvisioningDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("app_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: validateAppProvisioning,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application to provision users to.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the provisioning connection is enabled.",
+			},
+			"base_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIsURL,
+				Description:  "Base URL of the SCIM server, only configurable for apps that do not hardcode it.",
+			},
+			"token": secretSchema("API token Okta uses to authenticate to the SCIM server."),
+			"features": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(provisioningFeatures, false),
+				},
+				Description: "Provisioning features to enable, the ones not listed are disabled.",
+			},
+			"password_seed": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "OKTA",
+				ValidateFunc: validation.StringInSlice([]string{"OKTA", "RANDOM"}, false),
+				Description:  "Password pushed to the app when users are created, only used with PUSH_PASSWORD_UPDATES.",
+			},
+			"import_schedule": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Schedule of user imports, requires IMPORT_NEW_USERS.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"full_import": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Cron expression of full imports.",
+						},
+						"incremental_import": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Cron expression of incremental imports.",
+						},
+						"timezone": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "UTC",
+							Description: "IANA timezone the expressions are evaluated in.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateAppProvisioning(d *schema.ResourceDiff, m interface{}) error {
+	features := convertInterfaceToStringSet(d.Get("features"))
+
+	if len(features) > 0 && !d.Get("enabled").(bool) {
+		return fmt.Errorf("features require the provisioning connection to be enabled")
+	}
+
+	if len(d.Get("import_schedule").([]interface{})) > 0 && !contains(features, "IMPORT_NEW_USERS") {
+		return fmt.Errorf("import_schedule requires the IMPORT_NEW_USERS feature")
+	}
+
+	return nil
+}
+
+func resourceAppProvisioningRead(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	client := getSupplementFromMetadata(m)
+	connection, resp, err := client.GetAppConnection(appId)
+	if resp != nil && is404(resp.StatusCode) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return responseErr(resp, err)
+	}
+
+	userFeature, err := fetchAppFeature(appId, userProvisioning, m)
+	if err != nil {
+		return err
+	}
+
+	inboundFeature, err := fetchAppFeature(appId, inboundProvisioning, m)
+	if err != nil {
+		return err
+	}
+
+	d.Set("enabled", connection.Status == capabilityEnabled)
+	d.Set("base_url", connection.BaseUrl)
+
+	if userFeature != nil && userFeature.Capabilities != nil && userFeature.Capabilities.Update != nil {
+		if password := userFeature.Capabilities.Update.Password; password != nil && password.Seed != "" {
+			d.Set("password_seed", password.Seed)
+		}
+	}
+
+	return setNonPrimitives(d, map[string]interface{}{
+		"features":        convertStringSetToInterface(flattenProvisioningFeatures(userFeature, inboundFeature)),
+		"import_schedule": flattenImportSchedule(inboundFeature),
+	})
+}
+
+func resourceAppProvisioningPut(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	d.SetId(appId)
+
+	// Like on delete, the features are disabled while the connection still allows it
+	if !d.IsNewResource() && d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		oldFeatures, _ := d.GetChange("features")
+		if err := disableProvisioningFeatures(appId, convertInterfaceToStringSet(oldFeatures), m); err != nil {
+			return err
+		}
+	}
+
+	if err := updateAppConnection(d, m); err != nil {
+		return err
+	}
+
+	// Features cannot be changed while the connection is disabled, Okta keeps them as they are.
+	if d.Get("enabled").(bool) {
+		if err := updateProvisioningFeatures(d, m); err != nil {
+			return err
+		}
+	}
+
+	return resourceAppProvisioningRead(d, m)
+}
+
+func resourceAppProvisioningDelete(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+
+	if d.Get("enabled").(bool) {
+		if err := disableProvisioningFeatures(appId, convertInterfaceToStringSet(d.Get("features")), m); err != nil {
+			return err
+		}
+	}
+
+	resp, err := getSupplementFromMetadata(m).DeactivateAppConnection(appId)
+
+	return suppressErrorOn404(resp, err)
+}
+
+// Features cannot be changed once the connection is disabled and Okta keeps them enabled, so this has to happen first.
+func disableProvisioningFeatures(appId string, features []string, m interface{}) error {
+	client := getSupplementFromMetadata(m)
+
+	_, resp, err := client.UpdateAppFeature(appId, userProvisioning, buildUserProvisioningCapabilities(nil, ""))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+
+	if contains(features, "IMPORT_NEW_USERS") {
+		_, resp, err := client.UpdateAppFeatureLifecycle(appId, inboundProvisioning, "disable")
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns nil when the app does not support the feature.
+func fetchAppFeature(appId, name string, m interface{}) (*AppFeature, error) {
+	feature, resp, err := getSupplementFromMetadata(m).GetAppFeature(appId, name)
+	if resp != nil && is404(resp.StatusCode) {
+		return nil, nil
+	}
+
+	return feature, responseErr(resp, err)
+}
+
+func updateAppConnection(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	enabled := d.Get("enabled").(bool)
+	client := getSupplementFromMetadata(m)
+
+	// Okta never returns the token, it is only sent when it changes and is otherwise kept as it is.
+	if d.IsNewResource() || d.HasChange("base_url") || d.HasChange("token") {
+		connection := AppConnection{
+			BaseUrl: d.Get("base_url").(string),
+			Profile: &AppConnectionProfile{AuthScheme: "TOKEN"},
+		}
+		if d.IsNewResource() || d.HasChange("token") {
+			connection.Profile.Token = d.Get("token").(string)
+		}
+		if _, _, err := client.UpdateAppConnection(appId, connection, enabled); err != nil {
+			return fmt.Errorf("failed to configure provisioning connection of app %s: %v", appId, err)
+		}
+		// Activation is part of the request, but an existing connection is not deactivated by it
+		if d.IsNewResource() || enabled {
+			return nil
+		}
+	}
+
+	if d.IsNewResource() || !d.HasChange("enabled") {
+		return nil
+	}
+
+	var err error
+	if enabled {
+		_, err = client.ActivateAppConnection(appId)
+	} else {
+		_, err = client.DeactivateAppConnection(appId)
+	}
+
+	return err
+}
+
+func updateProvisioningFeatures(d *schema.ResourceData, m interface{}) error {
+	appId := d.Get("app_id").(string)
+	features := convertInterfaceToStringSet(d.Get("features"))
+	client := getSupplementFromMetadata(m)
+
+	capabilities := buildUserProvisioningCapabilities(features, d.Get("password_seed").(string))
+	if _, _, err := client.UpdateAppFeature(appId, userProvisioning, capabilities); err != nil {
+		return fmt.Errorf("failed to update %s of app %s: %v", userProvisioning, appId, err)
+	}
+
+	inboundFeature, err := fetchAppFeature(appId, inboundProvisioning, m)
+	if err != nil {
+		return err
+	}
+
+	importUsers := contains(features, "IMPORT_NEW_USERS")
+	if inboundFeature == nil {
+		if importUsers {
+			return fmt.Errorf("app %s does not support IMPORT_NEW_USERS", appId)
+		}
+		return nil
+	}
+
+	if importUsers != (inboundFeature.Status == capabilityEnabled) {
+		lifecycle := "disable"
+		if importUsers {
+			lifecycle = "enable"
+		}
+		if _, _, err := client.UpdateAppFeatureLifecycle(appId, inboundProvisioning, lifecycle); err != nil {
+			return fmt.Errorf("failed to %s %s of app %s: %v", lifecycle, inboundProvisioning, appId, err)
+		}
+	}
+
+	if importUsers {
+		_, _, err := client.UpdateAppFeature(appId, inboundProvisioning, buildImportCapabilities(d))
+		return err
+	}
+
+	return nil
+}
+
+func buildImportCapabilities(d *schema.ResourceData) *AppFeatureCapabilities {
+	schedule := &ImportSchedule{Status: capabilityDisabled}
+
+	if _, ok := d.GetOk("import_schedule"); ok {
+		timezone := d.Get("import_schedule.0.timezone").(string)
+		schedule = &ImportSchedule{
+			FullImport: &ImportScheduleExpression{
+				Expression: d.Get("import_schedule.0.full_import").(string),
+				Timezone:   timezone,
+			},
+			Status: capabilityEnabled,
+		}
+		if expression := d.Get("import_schedule.0.incremental_import").(string); expression != "" {
+			schedule.IncrementalImport = &ImportScheduleExpression{
+				Expression: expression,
+				Timezone:   timezone,
+			}
+		}
+	}
+
+	return &AppFeatureCapabilities{
+		ImportSettings: &ImportSettings{Schedule: schedule},
+	}
+}
+
+func flattenImportSchedule(inboundFeature *AppFeature) []interface{} {
+	if inboundFeature == nil || inboundFeature.Status != capabilityEnabled || inboundFeature.Capabilities == nil ||
+		inboundFeature.Capabilities.ImportSettings == nil {
+		return []interface{}{}
+	}
+
+	schedule := inboundFeature.Capabilities.ImportSettings.Schedule
+	if schedule == nil || schedule.Status != capabilityEnabled || schedule.FullImport == nil {
+		return []interface{}{}
+	}
+
+	flattened := map[string]interface{}{
+		"full_import": schedule.FullImport.Expression,
+		"timezone":    schedule.FullImport.Timezone,
+	}
+	if schedule.IncrementalImport != nil {
+		flattened["incremental_import"] = schedule.IncrementalImport.Expression
+	}
+
+	return []interface{}{flattened}
+}
@@ -0,0 +1,73 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOktaAppProvisioning(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appProvisioning)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appProvisioning)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: checkAppProvisioningDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", fmt.Sprintf("%s.test", appSaml), "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.0.full_import", "0 0 * * 0"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.0.incremental_import", "0 * * * *"),
+					resource.TestCheckResourceAttr(resourceName, "token", hashSecret(buildResourceName(ri))),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "features.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "password_seed", "RANDOM"),
+					resource.TestCheckResourceAttr(resourceName, "import_schedule.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+// The app is destroyed along with the resource, if it is still around its connection must be disabled
+func checkAppProvisioningDisabled(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != appProvisioning {
+			continue
+		}
+
+		connection, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetAppConnection(rs.Primary.ID)
+		if resp != nil && is404(resp.StatusCode) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if connection.Status == capabilityEnabled {
+			return fmt.Errorf("provisioning of app %s is still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/okta/okta-sdk-golang/okta"
 	"github.com/okta/okta-sdk-golang/okta/query"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 )
 
 const (
@@ -42,10 +45,17 @@ func resourceAppSaml() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			if !d.NewValueKnown("attribute_statements") {
+				return nil
+			}
+
+			return validateAttributeStatements(d.Get("attribute_statements").([]interface{}))
+		},
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
-		Schema: buildAppSchema(map[string]*schema.Schema{
+		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
 			"preconfigured_app": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -57,7 +67,7 @@ func resourceAppSaml() *schema.Resource {
 			},
 			"key_name": {
 				Type:        schema.TypeString,
-				Description: "Certificate name. This modulates the rotation of keys. New name == new key.",
+				Description: "Certificate name. This modulates the rotation of keys. New name == new key, which is activated immediately. Use pending_key_name and active_key_id for a staged rollover.",
 				Optional:    true,
 			},
 			"key_id": {
@@ -65,6 +75,71 @@ func resourceAppSaml() *schema.Resource {
 				Description: "Certificate ID",
 				Computed:    true,
 			},
+			"key_expires_at": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the active certificate",
+				Computed:    true,
+			},
+			"active_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the certificate used to sign assertions. Set it to pending_key_id once the service provider trusts the new certificate.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pending_key_name": {
+				Type:        schema.TypeString,
+				Description: "Certificate name. New name == new key, which is generated but not activated.",
+				Optional:    true,
+			},
+			"pending_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the generated certificate that is not active yet",
+				Computed:    true,
+			},
+			"pending_key_expires_at": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the pending certificate",
+				Computed:    true,
+			},
+			"pending_metadata": {
+				Type:        schema.TypeString,
+				Description: "SAML xml metadata payload of the pending certificate",
+				Computed:    true,
+			},
+			"pending_certificate": {
+				Type:        schema.TypeString,
+				Description: "cert from the SAML XML metadata payload of the pending certificate",
+				Computed:    true,
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All certificates of the application. Okta does not allow deleting them, inactive ones remain until they expire.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"x5t_s256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"key_years_valid": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -102,24 +177,6 @@ func resourceAppSaml() *schema.Resource {
 				Description: "Entity URL for instance http://www.okta.com/exk1fcia6d6EMsf331d8",
 				Computed:    true,
 			},
-			"auto_submit_toolbar": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Display auto submit toolbar",
-			},
-			"hide_ios": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Do not display application icon on mobile app",
-			},
-			"hide_web": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Do not display application icon to users",
-			},
 			"default_relay_state": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -219,6 +276,67 @@ func resourceAppSaml() *schema.Resource {
 				Optional:    true,
 				Description: "Identifies the SAML authentication context class for the assertion’s authentication statement",
 			},
+			"slo_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Single logout URL of the service provider, enables single logout",
+				ValidateFunc: validateIsURL,
+			},
+			"slo_issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Issuer of the logout requests sent by the service provider",
+			},
+			"slo_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM or base64 DER encoded X.509 certificate the service provider signs logout requests with",
+				ValidateFunc: validateCertificate,
+				StateFunc:    normalizeCertificate,
+			},
+			"assertion_encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Algorithm used to encrypt assertions, enables assertion encryption",
+				ValidateFunc: validation.StringInSlice([]string{"AES256_CBC", "AES256_GCM", "AES128_CBC", "AES128_GCM"}, false),
+			},
+			"assertion_key_transport_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Algorithm used to encrypt the assertion encryption key",
+				ValidateFunc: validation.StringInSlice([]string{"RSA_OAEP", "RSA_15"}, false),
+			},
+			"assertion_encryption_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM or base64 DER encoded X.509 certificate of the service provider assertions are encrypted for",
+				ValidateFunc: validateCertificate,
+				StateFunc:    normalizeCertificate,
+			},
+			"acs_endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Additional assertion consumer service URLs, service providers select one by index",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIsURL,
+						},
+						"index": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"inline_hook_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the SAML assertion inline hook to invoke",
+			},
 			"accessibility_self_service": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -277,14 +395,15 @@ func resourceAppSaml() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"filter_type": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Type of group attribute filter",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Type of group attribute filter, only valid for GROUP statements",
+							ValidateFunc: validation.StringInSlice([]string{"STARTS_WITH", "EQUALS", "CONTAINS", "REGEX"}, false),
 						},
 						"filter_value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Filter value to use",
+							Description: "Filter value to use, only valid for GROUP statements",
 						},
 						"name": {
 							Type:     schema.TypeString,
@@ -310,9 +429,10 @@ func resourceAppSaml() *schema.Resource {
 							}, false),
 						},
 						"values": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Expressions to evaluate, only valid for EXPRESSION statements",
+							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
@@ -340,22 +460,35 @@ func resourceAppSamlCreate(d *schema.ResourceData, m interface{}) error {
 	// Make sure to track in terraform prior to the creation of cert in case there is an error.
 	d.SetId(app.Id)
 
-	err = tryCreateCertificate(d, m, app.Id)
+	created, err := tryCreateCertificate(d, m, app.Id)
 	if err != nil {
 		return err
 	}
+	if created {
+		// Okta signs with the key generated along with the app until told otherwise
+		if err := updateSamlApp(d, m); err != nil {
+			return err
+		}
+	}
+	if err := tryCreatePendingCertificate(d, m, app.Id); err != nil {
+		return err
+	}
 	err = handleAppGroupsAndUsers(app.Id, d, m)
 
 	if err != nil {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSamlRead(d, m)
 }
 
 func resourceAppSamlRead(d *schema.ResourceData, m interface{}) error {
-	app := okta.NewSamlApplication()
-	err := fetchApp(d, m, app)
+	app := newSamlApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
 	if err != nil {
 		return err
 	}
@@ -375,6 +508,9 @@ func resourceAppSamlRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("digest_algorithm", app.Settings.SignOn.DigestAlgorithm)
 		d.Set("honor_force_authn", app.Settings.SignOn.HonorForceAuthn)
 		d.Set("authn_context_class_ref", app.Settings.SignOn.AuthnContextClassRef)
+		if err := setSamlSignOnExtensions(d, app.Settings.SignOn); err != nil {
+			return err
+		}
 	}
 
 	d.Set("features", convertStringSetToInterface(app.Features))
@@ -387,19 +523,23 @@ func resourceAppSamlRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := syncSamlKeys(d, m, app.Credentials.Signing.Kid); err != nil {
+		return err
+	}
+
 	if app.Credentials.Signing.Kid != "" && app.Status != "INACTIVE" {
 		keyID := app.Credentials.Signing.Kid
 		d.Set("key_id", keyID)
+		d.Set("active_key_id", keyID)
 		keyMetadata, err := getMetadata(d, m, keyID)
 		if err != nil {
 			return err
 		}
 		d.Set("metadata", string(keyMetadata))
 
-		metadataRoot := &saml.EntityDescriptor{}
-		err = xml.Unmarshal(keyMetadata, metadataRoot)
+		metadataRoot, err := parseSamlMetadata(keyMetadata)
 		if err != nil {
-			return fmt.Errorf("Could not parse SAML app metadata, error: %s", err)
+			return err
 		}
 		// Always grab the last one just for simplicity. Should never have duplicates.
 		for _, service := range metadataRoot.IDPSSODescriptors[0].SingleSignOnServices {
@@ -420,19 +560,37 @@ func resourceAppSamlRead(d *schema.ResourceData, m interface{}) error {
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
 	if app.Settings != nil && app.Settings.SignOn != nil {
-		for i, st := range app.Settings.SignOn.AttributeStatements {
-			d.Set(fmt.Sprintf("attribute_statements.%d.name", i), st.Name)
-			d.Set(fmt.Sprintf("attribute_statements.%d.namespace", i), st.Namespace)
-			d.Set(fmt.Sprintf("attribute_statements.%d.type", i), st.Type)
-			d.Set(fmt.Sprintf("attribute_statements.%d.values", i), st.Values)
+		statements := flattenAttributeStatements(app.Settings.SignOn.AttributeStatements, d.Get("attribute_statements").([]interface{}))
+		if err := setNonPrimitives(d, map[string]interface{}{"attribute_statements": statements}); err != nil {
+			return err
 		}
 	}
 
+	syncAppNotesAndLogo(d, raw)
+
 	return syncGroupsAndUsers(app.Id, d, m)
 }
 
 func resourceAppSamlUpdate(d *schema.ResourceData, m interface{}) error {
 	client := getOktaClientFromMetadata(m)
+
+	if d.HasChange("key_name") {
+		// A new key_name activates the generated key right away, taking precedence over active_key_id
+		if _, err := tryCreateCertificate(d, m, d.Id()); err != nil {
+			return err
+		}
+	} else if d.HasChange("active_key_id") {
+		if err := validateSamlKeyExists(d, m); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("pending_key_name") {
+		if err := tryCreatePendingCertificate(d, m, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	app, err := buildApp(d, m)
 
 	if err != nil {
@@ -452,18 +610,16 @@ func resourceAppSamlUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if d.HasChange("key_name") {
-		err = tryCreateCertificate(d, m, app.Id)
-		if err != nil {
-			return err
-		}
-	}
 	err = handleAppGroupsAndUsers(app.Id, d, m)
 
 	if err != nil {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSamlRead(d, m)
 }
 
@@ -479,9 +635,9 @@ func resourceAppSamlDelete(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
-func buildApp(d *schema.ResourceData, m interface{}) (*okta.SamlApplication, error) {
+func buildApp(d *schema.ResourceData, m interface{}) (*SamlApplication, error) {
 	// Abstracts away name and SignOnMode which are constant for this app type.
-	app := okta.NewSamlApplication()
+	app := newSamlApplication()
 	app.Label = d.Get("label").(string)
 	responseSigned := d.Get("response_signed").(bool)
 	assertionSigned := d.Get("assertion_signed").(bool)
@@ -505,18 +661,8 @@ func buildApp(d *schema.ResourceData, m interface{}) (*okta.SamlApplication, err
 	}
 
 	honorForce := d.Get("honor_force_authn").(bool)
-	autoSubmit := d.Get("auto_submit_toolbar").(bool)
-	hideMobile := d.Get("hide_ios").(bool)
-	hideWeb := d.Get("hide_web").(bool)
 	a11ySelfService := d.Get("accessibility_self_service").(bool)
-	app.Settings = okta.NewSamlApplicationSettings()
-	app.Visibility = &okta.ApplicationVisibility{
-		AutoSubmitToolbar: &autoSubmit,
-		Hide: &okta.ApplicationVisibilityHide{
-			IOS: &hideMobile,
-			Web: &hideWeb,
-		},
-	}
+	app.Visibility = buildVisibility(d)
 	if appSettings, ok := d.GetOk("app_settings_json"); ok {
 		payload := map[string]interface{}{}
 		json.Unmarshal([]byte(appSettings.(string)), &payload)
@@ -524,7 +670,7 @@ func buildApp(d *schema.ResourceData, m interface{}) (*okta.SamlApplication, err
 		app.Settings.App = &settings
 	}
 	app.Features = convertInterfaceToStringSet(d.Get("features"))
-	app.Settings.SignOn = &okta.SamlApplicationSettingsSignOn{
+	app.Settings.SignOn.SamlApplicationSettingsSignOn = &okta.SamlApplicationSettingsSignOn{
 		DefaultRelayState:     d.Get("default_relay_state").(string),
 		SsoAcsUrl:             d.Get("sso_url").(string),
 		Recipient:             d.Get("recipient").(string),
@@ -554,29 +700,222 @@ func buildApp(d *schema.ResourceData, m interface{}) (*okta.SamlApplication, err
 	}
 	statements := d.Get("attribute_statements").([]interface{})
 	if len(statements) > 0 {
-		samlAttr := make([]*okta.SamlAttributeStatement, len(statements))
+		samlAttr := make([]*AttributeStatement, len(statements))
 		for i := range statements {
-			samlAttr[i] = &okta.SamlAttributeStatement{
+			samlAttr[i] = &AttributeStatement{
 				Name:      d.Get(fmt.Sprintf("attribute_statements.%d.name", i)).(string),
 				Namespace: d.Get(fmt.Sprintf("attribute_statements.%d.namespace", i)).(string),
 				Type:      d.Get(fmt.Sprintf("attribute_statements.%d.type", i)).(string),
-				Values:    convertInterfaceToStringArr(d.Get(fmt.Sprintf("attribute_statements.%d.values", i))),
+			}
+			if samlAttr[i].Type == "GROUP" {
+				samlAttr[i].FilterType = d.Get(fmt.Sprintf("attribute_statements.%d.filter_type", i)).(string)
+				samlAttr[i].FilterValue = d.Get(fmt.Sprintf("attribute_statements.%d.filter_value", i)).(string)
+			} else {
+				samlAttr[i].Values = convertInterfaceToStringArr(d.Get(fmt.Sprintf("attribute_statements.%d.values", i)))
 			}
 		}
 		app.Settings.SignOn.AttributeStatements = samlAttr
 	}
 
-	if id, ok := d.GetOk("key_id"); ok {
-		app.Credentials = &okta.ApplicationCredentials{
-			Signing: &okta.ApplicationCredentialsSigning{
-				Kid: id.(string),
-			},
+	if err := buildSamlSignOnExtensions(d, app.Settings.SignOn); err != nil {
+		return app, err
+	}
+
+	if id, ok := d.GetOk("active_key_id"); ok {
+		app.Credentials.Signing = &okta.ApplicationCredentialsSigning{
+			Kid: id.(string),
 		}
 	}
 
 	return app, nil
 }
 
+func validateAttributeStatements(statements []interface{}) error {
+	names := map[string]bool{}
+	for i, raw := range statements {
+		statement, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := statement["name"].(string)
+		if names[name] {
+			return fmt.Errorf("attribute_statements.%d: name %s is used more than once", i, name)
+		}
+		names[name] = true
+
+		filterType, _ := statement["filter_type"].(string)
+		filterValue, _ := statement["filter_value"].(string)
+		values, _ := statement["values"].([]interface{})
+		if statement["type"] == "GROUP" {
+			if filterType == "" || filterValue == "" {
+				return fmt.Errorf("attribute_statements.%d: GROUP statements require filter_type and filter_value", i)
+			}
+			if len(values) > 0 {
+				return fmt.Errorf("attribute_statements.%d: GROUP statements do not support values", i)
+			}
+			if filterType == "REGEX" {
+				if _, err := regexp.Compile(filterValue); err != nil {
+					return fmt.Errorf("attribute_statements.%d: filter_value is not a valid regular expression, %v", i, err)
+				}
+			}
+			continue
+		}
+
+		if filterType != "" || filterValue != "" {
+			return fmt.Errorf("attribute_statements.%d: filter_type and filter_value are only supported by GROUP statements", i)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("attribute_statements.%d: EXPRESSION statements require at least one value", i)
+		}
+	}
+
+	return nil
+}
+
+// Okta does not guarantee the order of statements, configured ones keep their position and new ones are sorted by name.
+func flattenAttributeStatements(statements []*AttributeStatement, configured []interface{}) []map[string]interface{} {
+	position := map[string]int{}
+	for i, raw := range configured {
+		if statement, ok := raw.(map[string]interface{}); ok {
+			position[statement["name"].(string)] = i
+		}
+	}
+	sorted := make([]*AttributeStatement, len(statements))
+	copy(sorted, statements)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, iok := position[sorted[i].Name]
+		pj, jok := position[sorted[j].Name]
+		if iok && jok {
+			return pi < pj
+		}
+		if iok != jok {
+			return iok
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	arr := make([]map[string]interface{}, len(sorted))
+	for i, statement := range sorted {
+		arr[i] = map[string]interface{}{
+			"filter_type":  statement.FilterType,
+			"filter_value": statement.FilterValue,
+			"name":         statement.Name,
+			"namespace":    statement.Namespace,
+			"type":         statement.Type,
+			"values":       convertStringArrToInterface(statement.Values),
+		}
+	}
+
+	return arr
+}
+
+func buildSamlSignOnExtensions(d *schema.ResourceData, signOn *SamlApplicationSettingsSignOn) error {
+	if sloUrl, ok := d.GetOk("slo_url"); ok {
+		if err := conditionalRequire(d, []string{"slo_issuer", "slo_certificate"}, "single logout requires them"); err != nil {
+			return err
+		}
+		enabled := true
+		signOn.Slo = &SingleLogout{
+			Enabled:   &enabled,
+			Issuer:    d.Get("slo_issuer").(string),
+			LogoutUrl: sloUrl.(string),
+		}
+		signOn.SpCertificate = &SpCertificate{X5c: certificateToX5c(d.Get("slo_certificate").(string))}
+	} else {
+		// Omitting it would leave single logout enabled once it has been removed from the config
+		disabled := false
+		signOn.Slo = &SingleLogout{Enabled: &disabled}
+	}
+
+	if algorithm, ok := d.GetOk("assertion_encryption_algorithm"); ok {
+		reason := "assertion encryption requires them"
+		if err := conditionalRequire(d, []string{"assertion_key_transport_algorithm", "assertion_encryption_certificate"}, reason); err != nil {
+			return err
+		}
+		enabled := true
+		signOn.AssertionEncryption = &AssertionEncryption{
+			Enabled:               &enabled,
+			EncryptionAlgorithm:   algorithm.(string),
+			KeyTransportAlgorithm: d.Get("assertion_key_transport_algorithm").(string),
+			X5c:                   certificateToX5c(d.Get("assertion_encryption_certificate").(string)),
+		}
+	} else {
+		disabled := false
+		signOn.AssertionEncryption = &AssertionEncryption{Enabled: &disabled}
+	}
+
+	endpoints := d.Get("acs_endpoints").([]interface{})
+	allowMultiple := len(endpoints) > 0
+	signOn.AllowMultipleAcsEndpoints = &allowMultiple
+	if allowMultiple {
+		signOn.AcsEndpoints = make([]*AcsEndpoint, len(endpoints))
+		indexes := map[int]bool{}
+		for i := range endpoints {
+			index := d.Get(fmt.Sprintf("acs_endpoints.%d.index", i)).(int)
+			if indexes[index] {
+				return fmt.Errorf("acs_endpoints index %d is used more than once", index)
+			}
+			indexes[index] = true
+			signOn.AcsEndpoints[i] = &AcsEndpoint{
+				Index: index,
+				Url:   d.Get(fmt.Sprintf("acs_endpoints.%d.url", i)).(string),
+			}
+		}
+	}
+
+	if hookId, ok := d.GetOk("inline_hook_id"); ok {
+		signOn.InlineHooks = []*SignOnInlineHook{{Id: hookId.(string)}}
+	}
+
+	return nil
+}
+
+func setSamlSignOnExtensions(d *schema.ResourceData, signOn *SamlApplicationSettingsSignOn) error {
+	// Okta keeps the settings of disabled features around, they are only read while the feature is enabled
+	sloUrl, sloIssuer, sloCertificate := "", "", ""
+	if signOn.Slo != nil && signOn.Slo.Enabled != nil && *signOn.Slo.Enabled {
+		sloUrl = signOn.Slo.LogoutUrl
+		sloIssuer = signOn.Slo.Issuer
+		if signOn.SpCertificate != nil && len(signOn.SpCertificate.X5c) > 0 {
+			sloCertificate = signOn.SpCertificate.X5c[0]
+		}
+	}
+	d.Set("slo_url", sloUrl)
+	d.Set("slo_issuer", sloIssuer)
+	d.Set("slo_certificate", sloCertificate)
+
+	encryption := signOn.AssertionEncryption
+	if encryption != nil && encryption.Enabled != nil && *encryption.Enabled {
+		d.Set("assertion_encryption_algorithm", encryption.EncryptionAlgorithm)
+		d.Set("assertion_key_transport_algorithm", encryption.KeyTransportAlgorithm)
+		if len(encryption.X5c) > 0 {
+			d.Set("assertion_encryption_certificate", encryption.X5c[0])
+		}
+	} else {
+		d.Set("assertion_encryption_algorithm", "")
+		d.Set("assertion_key_transport_algorithm", "")
+		d.Set("assertion_encryption_certificate", "")
+	}
+
+	endpoints := []map[string]interface{}{}
+	if signOn.AllowMultipleAcsEndpoints != nil && *signOn.AllowMultipleAcsEndpoints {
+		for _, endpoint := range signOn.AcsEndpoints {
+			endpoints = append(endpoints, map[string]interface{}{
+				"index": endpoint.Index,
+				"url":   endpoint.Url,
+			})
+		}
+	}
+
+	hookId := ""
+	if len(signOn.InlineHooks) > 0 {
+		hookId = signOn.InlineHooks[0].Id
+	}
+	d.Set("inline_hook_id", hookId)
+
+	return setNonPrimitives(d, map[string]interface{}{"acs_endpoints": endpoints})
+}
+
 func getCertificate(d *schema.ResourceData, m interface{}) (*okta.JsonWebKey, error) {
 	client := getOktaClientFromMetadata(m)
 	keyId := d.Get("key.id").(string)
@@ -616,16 +955,130 @@ func generateCertificate(d *schema.ResourceData, m interface{}, appID string) (*
 	return key, err
 }
 
-func tryCreateCertificate(d *schema.ResourceData, m interface{}, appID string) error {
+func tryCreateCertificate(d *schema.ResourceData, m interface{}, appID string) (bool, error) {
 	if _, ok := d.GetOk("key_name"); ok {
 		key, err := generateCertificate(d, m, appID)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Set ID and the read done at the end of update and create will do the GET on metadata
 		d.Set("key_id", key.Kid)
+		d.Set("active_key_id", key.Kid)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// Generates a key without activating it, giving service providers time to trust it before active_key_id is flipped.
+func tryCreatePendingCertificate(d *schema.ResourceData, m interface{}, appID string) error {
+	if _, ok := d.GetOk("pending_key_name"); ok {
+		key, err := generateCertificate(d, m, appID)
+		if err != nil {
+			return err
+		}
+
+		d.Set("pending_key_id", key.Kid)
 	}
 
 	return nil
 }
+
+func updateSamlApp(d *schema.ResourceData, m interface{}) error {
+	app, err := buildApp(d, m)
+	if err != nil {
+		return err
+	}
+	_, _, err = getOktaClientFromMetadata(m).Application.UpdateApplication(d.Id(), app)
+
+	return err
+}
+
+func validateSamlKeyExists(d *schema.ResourceData, m interface{}) error {
+	kid := d.Get("active_key_id").(string)
+	keys, _, err := getOktaClientFromMetadata(m).Application.ListApplicationKeys(d.Id())
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Kid == kid {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("active_key_id %s is not a certificate of application %s, see pending_key_id", kid, d.Id())
+}
+
+func parseSamlMetadata(raw []byte) (*saml.EntityDescriptor, error) {
+	metadataRoot := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal(raw, metadataRoot); err != nil {
+		return nil, fmt.Errorf("Could not parse SAML app metadata, error: %s", err)
+	}
+
+	return metadataRoot, nil
+}
+
+func formatKeyTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// Surfaces every certificate with its expiry and tracks the pending one until it becomes active.
+func syncSamlKeys(d *schema.ResourceData, m interface{}, activeKid string) error {
+	keys, resp, err := getOktaClientFromMetadata(m).Application.ListApplicationKeys(d.Id())
+	if err = suppressErrorOn404(resp, err); err != nil {
+		return err
+	}
+
+	pendingKid := d.Get("pending_key_id").(string)
+	pendingFound := false
+	arr := make([]map[string]interface{}, len(keys))
+	for i, key := range keys {
+		arr[i] = map[string]interface{}{
+			"kid":        key.Kid,
+			"created":    formatKeyTime(key.Created),
+			"expires_at": formatKeyTime(key.ExpiresAt),
+			"x5t_s256":   key.X5tS256,
+			"active":     key.Kid == activeKid,
+		}
+		if key.Kid == activeKid {
+			d.Set("key_expires_at", formatKeyTime(key.ExpiresAt))
+		}
+		if key.Kid == pendingKid && key.Kid != activeKid {
+			pendingFound = true
+			d.Set("pending_key_expires_at", formatKeyTime(key.ExpiresAt))
+		}
+	}
+
+	if !pendingFound {
+		// Either the rollover is complete or there is none in progress
+		d.Set("pending_key_id", "")
+		d.Set("pending_key_expires_at", "")
+		d.Set("pending_metadata", "")
+		d.Set("pending_certificate", "")
+	} else {
+		keyMetadata, err := getMetadata(d, m, pendingKid)
+		if err != nil {
+			return err
+		}
+		if keyMetadata == nil {
+			return fmt.Errorf("no metadata found for pending key %s of application %s", pendingKid, d.Id())
+		}
+		metadataRoot, err := parseSamlMetadata(keyMetadata)
+		if err != nil {
+			return err
+		}
+		if len(metadataRoot.IDPSSODescriptors) == 0 || len(metadataRoot.IDPSSODescriptors[0].KeyDescriptors) == 0 {
+			return fmt.Errorf("metadata of pending key %s of application %s has no signing certificate", pendingKid, d.Id())
+		}
+		d.Set("pending_metadata", string(keyMetadata))
+		d.Set("pending_certificate", metadataRoot.IDPSSODescriptors[0].KeyDescriptors[0].KeyInfo.Certificate)
+	}
+
+	return setNonPrimitives(d, map[string]interface{}{"keys": arr})
+}
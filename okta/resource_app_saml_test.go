@@ -3,7 +3,10 @@ package okta
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -152,7 +155,13 @@ func TestAccOktaappSamllicationAllFields(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.namespace", "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.values.0", "val"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.1.name", "Attr Two"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.0.values.1", "${user.email}"),
 					resource.TestCheckResourceAttr(resourceName, "attribute_statements.1.namespace", "urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.1.type", "GROUP"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.1.filter_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.1.filter_value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.2.name", "Attr Three"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements.2.filter_type", "REGEX"),
 				),
 			},
 			{
@@ -172,6 +181,123 @@ func TestAccOktaappSamllicationAllFields(t *testing.T) {
 	})
 }
 
+func TestAccOktaappSamllicationSpFeatures(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("custom_saml_app_sp_features.tf", ri, t)
+	removedConfig := mgr.GetFixtures("custom_saml_app_sp_features_removed.tf", ri, t)
+	resourceName := buildResourceFQN(appSaml, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSamlApplication())),
+					resource.TestCheckResourceAttr(resourceName, "slo_url", "http://google.com/logout"),
+					resource.TestCheckResourceAttr(resourceName, "slo_issuer", "http://google.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "slo_certificate"),
+					resource.TestCheckResourceAttr(resourceName, "assertion_encryption_algorithm", "AES256_CBC"),
+					resource.TestCheckResourceAttr(resourceName, "assertion_key_transport_algorithm", "RSA_OAEP"),
+					resource.TestCheckResourceAttr(resourceName, "acs_endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "acs_endpoints.1.url", "http://google.com/acs/other"),
+					resource.TestCheckResourceAttr(resourceName, "acs_endpoints.1.index", "1"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slo_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "slo_certificate", ""),
+					resource.TestCheckResourceAttr(resourceName, "assertion_encryption_algorithm", ""),
+					resource.TestCheckResourceAttr(resourceName, "acs_endpoints.#", "0"),
+				),
+			},
+			{
+				Config:      strings.Replace(config, "BEGIN CERTIFICATE-----\n", "BEGIN CERTIFICATE-----\nnot", 1),
+				ExpectError: regexp.MustCompile("is not a valid X.509 certificate"),
+			},
+		},
+	})
+}
+
+// Stage a new certificate, activate it in a later apply and ensure the previous one is kept around
+func TestAccOktaappSamllicationKeyRollover(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSaml)
+	config := mgr.GetFixtures("key_rollover.tf", ri, t)
+	pendingConfig := mgr.GetFixtures("key_rollover_pending.tf", ri, t)
+	resourceName := buildResourceFQN(appSaml, ri)
+	var activeKid string
+
+	// The pending key ID is only known once it is generated and the resource cannot reference itself, so the last
+	// step reads it from the file it is saved to.
+	pendingKidFile, err := ioutil.TempFile("", "pending_key_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pendingKidFile.Close()
+	defer os.Remove(pendingKidFile.Name())
+	activatedConfig := strings.Replace(mgr.GetFixtures("key_rollover_activated.tf", ri, t), "replace_with_pending_key_file", pendingKidFile.Name(), -1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_expires_at"),
+					resource.TestCheckResourceAttr(resourceName, "pending_key_id", ""),
+					func(s *terraform.State) error {
+						activeKid = s.RootModule().Resources[resourceName].Primary.Attributes["key_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: pendingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "pending_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "pending_key_expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "pending_metadata"),
+					resource.TestCheckResourceAttrSet(resourceName, "pending_certificate"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["key_id"] != activeKid {
+							return fmt.Errorf("generating a pending key switched the active key from %s to %s", activeKid, attrs["key_id"])
+						}
+						return ioutil.WriteFile(pendingKidFile.Name(), []byte(attrs["pending_key_id"]), 0644)
+					},
+				),
+			},
+			{
+				Config: activatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pending_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "pending_certificate", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", resourceName, "active_key_id"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["key_id"] == activeKid {
+							return fmt.Errorf("expected active key to change from %s", activeKid)
+						}
+						if attrs["keys.#"] == "" || attrs["keys.#"] == "0" || attrs["keys.#"] == "1" {
+							return fmt.Errorf("expected previous keys to be listed, got %s", attrs["keys.#"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Add and remove groups/users
 func TestAccOktaappSamllicationUserGroups(t *testing.T) {
 	ri := acctest.RandInt()
@@ -233,3 +359,60 @@ resource "%s" "%s" {
 }
 `, appSaml, name, name)
 }
+
+func TestValidateAttributeStatements(t *testing.T) {
+	expression := func(name string, values ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": "EXPRESSION", "values": values}
+	}
+	group := func(name, filterType, filterValue string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "type": "GROUP", "filter_type": filterType, "filter_value": filterValue}
+	}
+	withFilter := expression("filtered", "user.email")
+	withFilter["filter_type"] = "EQUALS"
+	withValues := group("groups", "EQUALS", "admins")
+	withValues["values"] = []interface{}{"user.email"}
+
+	tests := []struct {
+		statements []interface{}
+		wantErr    string
+	}{
+		{[]interface{}{expression("email", "user.email", "user.login"), group("groups", "REGEX", "^admins-.*$")}, ""},
+		{[]interface{}{expression("email")}, "require at least one value"},
+		{[]interface{}{withFilter}, "only supported by GROUP statements"},
+		{[]interface{}{group("groups", "", "admins")}, "require filter_type and filter_value"},
+		{[]interface{}{withValues}, "do not support values"},
+		{[]interface{}{group("groups", "REGEX", "admins-(")}, "not a valid regular expression"},
+		{[]interface{}{expression("email", "user.email"), expression("email", "user.login")}, "used more than once"},
+	}
+
+	for i, tt := range tests {
+		err := validateAttributeStatements(tt.statements)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("case %d: unexpected error %v", i, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("case %d: expected error containing %q, got %v", i, tt.wantErr, err)
+		}
+	}
+}
+
+func TestFlattenAttributeStatements(t *testing.T) {
+	statements := []*AttributeStatement{
+		{Name: "zeta", Type: "EXPRESSION", Values: []string{"user.login"}},
+		{Name: "groups", Type: "GROUP", FilterType: "EQUALS", FilterValue: "admins"},
+		{Name: "alpha", Type: "EXPRESSION", Values: []string{"user.email"}},
+		{Name: "email", Type: "EXPRESSION", Values: []string{"user.email"}},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"name": "email"},
+		map[string]interface{}{"name": "groups"},
+	}
+
+	var names []string
+	for _, statement := range flattenAttributeStatements(statements, configured) {
+		names = append(names, statement["name"].(string))
+	}
+	if strings.Join(names, ",") != "email,groups,alpha,zeta" {
+		t.Errorf("unexpected order %v", names)
+	}
+}
@@ -2,7 +2,6 @@ package okta
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/okta/okta-sdk-golang/okta"
 	"github.com/okta/okta-sdk-golang/okta/query"
 )
@@ -17,6 +16,7 @@ func resourceAppSecurePasswordStore() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateSchemeCreds,
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
@@ -67,38 +67,6 @@ func resourceAppSecurePasswordStore() *schema.Resource {
 				Optional:    true,
 				Description: "Name of optional value in login form",
 			},
-			"credentials_scheme": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "EDIT_USERNAME_AND_PASSWORD",
-				ValidateFunc: validation.StringInSlice(
-					[]string{
-						"EDIT_USERNAME_AND_PASSWORD",
-						"ADMIN_SETS_CREDENTIALS",
-						"EDIT_PASSWORD_ONLY",
-						"EXTERNAL_PASSWORD_SYNC",
-						"SHARED_USERNAME_AND_PASSWORD",
-					},
-					false,
-				),
-				Description: "Application credentials scheme",
-			},
-			"reveal_password": &schema.Schema{
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Allow user to reveal password",
-			},
-			"shared_username": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Shared username, required for certain schemes.",
-			},
-			"shared_password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Shared password, required for certain schemes.",
-			},
 		}),
 	}
 }
@@ -116,12 +84,20 @@ func resourceAppSecurePasswordStoreCreate(d *schema.ResourceData, m interface{})
 
 	d.SetId(app.Id)
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSecurePasswordStoreRead(d, m)
 }
 
 func resourceAppSecurePasswordStoreRead(d *schema.ResourceData, m interface{}) error {
 	app := okta.NewSecurePasswordStoreApplication()
-	err := fetchApp(d, m, app)
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -135,14 +111,12 @@ func resourceAppSecurePasswordStoreRead(d *schema.ResourceData, m interface{}) e
 	d.Set("optional_field2_value", app.Settings.App.OptionalField2Value)
 	d.Set("optional_field3", app.Settings.App.OptionalField3)
 	d.Set("optional_field3_value", app.Settings.App.OptionalField3Value)
-	d.Set("credentials_scheme", app.Credentials.Scheme)
-	d.Set("reveal_password", app.Credentials.RevealPassword)
-	d.Set("shared_username", app.Credentials.UserName)
-	d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
+	setSchemeCreds(d, app.Credentials)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
-	return nil
+	syncAppNotesAndLogo(d, raw)
+
+	return syncGroupsAndUsers(app.Id, d, m)
 }
 
 func resourceAppSecurePasswordStoreUpdate(d *schema.ResourceData, m interface{}) error {
@@ -161,6 +135,14 @@ func resourceAppSecurePasswordStoreUpdate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSecurePasswordStoreRead(d, m)
 }
 
@@ -8,9 +8,6 @@ import (
 
 func resourceAppSwa() *schema.Resource {
 	return &schema.Resource{
-		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
-			return nil
-		},
 		Create: resourceAppSwaCreate,
 		Read:   resourceAppSwaRead,
 		Update: resourceAppSwaUpdate,
@@ -19,8 +16,9 @@ func resourceAppSwa() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateSchemeCreds,
 
-		Schema: buildAppSwaSchema(map[string]*schema.Schema{
+		Schema: buildAppSwaSchemaWithComputedScheme(map[string]*schema.Schema{
 			"preconfigured_app": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,12 +73,16 @@ func resourceAppSwaCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSwaRead(d, m)
 }
 
 func resourceAppSwaRead(d *schema.ResourceData, m interface{}) error {
-	app := okta.NewSwaApplication()
-	err := fetchApp(d, m, app)
+	app := newSwaApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -91,10 +93,11 @@ func resourceAppSwaRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("username_field", app.Settings.App.UsernameField)
 	d.Set("url", app.Settings.App.Url)
 	d.Set("url_regex", app.Settings.App.LoginUrlRegex)
-	d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
+	setSchemeCreds(d, app.Credentials)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
+	syncAppNotesAndLogo(d, raw)
+
 	return syncGroupsAndUsers(app.Id, d, m)
 }
 
@@ -120,6 +123,10 @@ func resourceAppSwaUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppSwaRead(d, m)
 }
 
@@ -135,9 +142,9 @@ func resourceAppSwaDelete(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
-func buildAppSwa(d *schema.ResourceData, m interface{}) *okta.SwaApplication {
+func buildAppSwa(d *schema.ResourceData, m interface{}) *SwaApplication {
 	// Abstracts away name and SignOnMode which are constant for this app type.
-	app := okta.NewSwaApplication()
+	app := newSwaApplication()
 	app.Label = d.Get("label").(string)
 	name := d.Get("preconfigured_app").(string)
 
@@ -155,6 +162,7 @@ func buildAppSwa(d *schema.ResourceData, m interface{}) *okta.SwaApplication {
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Credentials = buildSchemeCreds(d)
 
 	return app
 }
@@ -114,6 +114,46 @@ func TestAccOktaappSwalicationUserGroups(t *testing.T) {
 	})
 }
 
+// Per user credentials are only sent when the credentials scheme accepts them
+func TestAccOktaappSwalicationCredentialsScheme(t *testing.T) {
+	ri := acctest.RandInt()
+	config := buildTestSwaCredentialsScheme(ri, "ADMIN_SETS_CREDENTIALS", "first")
+	updatedConfig := buildTestSwaCredentialsScheme(ri, "ADMIN_SETS_CREDENTIALS", "second")
+	syncConfig := buildTestSwaCredentialsScheme(ri, "EXTERNAL_PASSWORD_SYNC", "second")
+	resourceName := buildResourceFQN(appSwa, ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appSwa, createDoesAppExist(okta.NewSwaApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewSwaApplication())),
+					resource.TestCheckResourceAttr(resourceName, "credentials_scheme", "ADMIN_SETS_CREDENTIALS"),
+					resource.TestCheckResourceAttr(resourceName, "reveal_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_name_template", "${source.email}"),
+					resource.TestCheckResourceAttr(resourceName, "user_name_template_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: syncConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credentials_scheme", "EXTERNAL_PASSWORD_SYNC"),
+				),
+			},
+		},
+	})
+}
+
 func buildTestSwaConfigPreconfig(rInt int) string {
 	name := buildResourceName(rInt)
 
@@ -219,3 +259,36 @@ resource "%s" "%s" {
 }
 `, appSwa, name, name)
 }
+
+func buildTestSwaCredentialsScheme(rInt int, scheme, password string) string {
+	name := buildResourceName(rInt)
+
+	return fmt.Sprintf(`
+resource "okta_user" "user-%d" {
+  first_name = "TestAcc"
+  last_name  = "blah"
+  login      = "test-acc-%d@testing.com"
+  email      = "test-acc-%d@testing.com"
+}
+
+resource "%s" "%s" {
+  label                   = "%s"
+  button_field            = "btn-login"
+  password_field          = "txtbox-password"
+  username_field          = "txtbox-username"
+  url                     = "https://example.com/login.html"
+  credentials_scheme      = "%s"
+  reveal_password         = true
+  user_name_template      = "$${source.email}"
+  user_name_template_type = "CUSTOM"
+
+  users = [
+    {
+      id       = "${okta_user.user-%d.id}"
+      username = "${okta_user.user-%d.email}"
+      password = "%s"
+    },
+  ]
+}
+`, rInt, rInt, rInt, appSwa, name, name, scheme, rInt, rInt, password)
+}
@@ -16,10 +16,11 @@ func resourceAppThreeField() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateSchemeCreds,
 
 		// For those familiar with Terraform schemas be sure to check the base application schema and/or
 		// the examples in the documentation
-		Schema: buildAppSwaSchema(map[string]*schema.Schema{
+		Schema: buildAppSwaSchemaWithComputedScheme(map[string]*schema.Schema{
 			"button_selector": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -73,12 +74,20 @@ func resourceAppThreeFieldCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(app.Id)
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppThreeFieldRead(d, m)
 }
 
 func resourceAppThreeFieldRead(d *schema.ResourceData, m interface{}) error {
-	app := okta.NewSwaThreeFieldApplication()
-	err := fetchApp(d, m, app)
+	app := newSwaThreeFieldApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
 
 	if err != nil {
 		return err
@@ -91,11 +100,12 @@ func resourceAppThreeFieldRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("extra_field_value", app.Settings.App.ExtraFieldValue)
 	d.Set("url", app.Settings.App.TargetURL)
 	d.Set("url_regex", app.Settings.App.LoginUrlRegex)
-	d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
+	setSchemeCreds(d, app.Credentials)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
 
-	return nil
+	syncAppNotesAndLogo(d, raw)
+
+	return syncGroupsAndUsers(app.Id, d, m)
 }
 
 func resourceAppThreeFieldUpdate(d *schema.ResourceData, m interface{}) error {
@@ -114,6 +124,14 @@ func resourceAppThreeFieldUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
 	return resourceAppThreeFieldRead(d, m)
 }
 
@@ -127,8 +145,8 @@ func resourceAppThreeFieldDelete(d *schema.ResourceData, m interface{}) error {
 	return responseErr(client.Application.DeleteApplication(d.Id()))
 }
 
-func buildAppThreeField(d *schema.ResourceData, m interface{}) *okta.SwaThreeFieldApplication {
-	app := okta.NewSwaThreeFieldApplication()
+func buildAppThreeField(d *schema.ResourceData, m interface{}) *SwaThreeFieldApplication {
+	app := newSwaThreeFieldApplication()
 	app.Label = d.Get("label").(string)
 
 	app.Settings = &okta.SwaThreeFieldApplicationSettings{
@@ -143,6 +161,7 @@ func buildAppThreeField(d *schema.ResourceData, m interface{}) *okta.SwaThreeFie
 		},
 	}
 	app.Visibility = buildVisibility(d)
+	app.Credentials = buildSchemeCreds(d)
 
 	return app
 }
@@ -0,0 +1,225 @@
+package okta
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
+func resourceAppWsFederation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppWsFederationCreate,
+		Read:   resourceAppWsFederationRead,
+		Update: resourceAppWsFederationUpdate,
+		Delete: resourceAppWsFederationDelete,
+		Exists: resourceAppExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// For those familiar with Terraform schemas be sure to check the base application schema and/or
+		// the examples in the documentation
+		Schema: buildAppSchemaWithVisibility(map[string]*schema.Schema{
+			"site_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Launch URL of the relying party",
+				ValidateFunc: validateIsURL,
+			},
+			"realm": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Realm identifying the relying party, usually its URI",
+			},
+			"reply_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL tokens are posted to",
+				ValidateFunc: validateIsURL,
+			},
+			"reply_override": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow the relying party to override reply_url with the wreply parameter",
+			},
+			"name_id_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+						"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+						"urn:oasis:names:tc:SAML:1.1:nameid-format:x509SubjectName",
+						"urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+						"urn:oasis:names:tc:SAML:2.0:nameid-format:transient",
+					},
+					false,
+				),
+				Description: "Format of the name identifier of the subject",
+			},
+			"audience_restriction": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Audience of the tokens, defaults to realm",
+			},
+			"authn_context_class_ref": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport",
+				Description: "Authentication context class of the assertion's authentication statement",
+			},
+			"username_attribute": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "username",
+				Description:  "Attribute the subject name is taken from",
+				ValidateFunc: validation.StringInSlice([]string{"username", "upn", "upnAndUsername", "none"}, false),
+			},
+			"attribute_statements": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute statements in Okta's name|expression|namespace format, comma separated",
+			},
+			"group_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the attribute group memberships are sent in",
+			},
+			"group_filter": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Regular expression selecting the groups sent",
+			},
+			"group_value_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Format of the group values",
+				ValidateFunc: validation.StringInSlice([]string{"windowsDomainQualifiedName", "samAccountName", "dn"}, false),
+			},
+		}),
+	}
+}
+
+func resourceAppWsFederationCreate(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppWsFederation(d, m)
+	activate := d.Get("status").(string) == "ACTIVE"
+	params := &query.Params{Activate: &activate}
+	_, _, err := client.Application.CreateApplication(app, params)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(app.Id)
+
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
+	return resourceAppWsFederationRead(d, m)
+}
+
+func resourceAppWsFederationRead(d *schema.ResourceData, m interface{}) error {
+	app := okta.NewWsFederationApplication()
+	raw, err := fetchAppAndRaw(d, m, app)
+
+	if err != nil {
+		return err
+	}
+
+	if app.Settings != nil && app.Settings.App != nil {
+		settings := app.Settings.App
+		d.Set("site_url", settings.SiteURL)
+		d.Set("realm", settings.Realm)
+		d.Set("reply_url", settings.WReplyURL)
+		d.Set("reply_override", settings.WReplyOverride)
+		d.Set("name_id_format", settings.NameIDFormat)
+		d.Set("audience_restriction", settings.AudienceRestriction)
+		d.Set("authn_context_class_ref", settings.AuthnContextClassRef)
+		d.Set("username_attribute", settings.UsernameAttribute)
+		d.Set("attribute_statements", settings.AttributeStatements)
+		d.Set("group_name", settings.GroupName)
+		d.Set("group_filter", settings.GroupFilter)
+		d.Set("group_value_format", settings.GroupValueFormat)
+	}
+	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility)
+
+	syncAppNotesAndLogo(d, raw)
+
+	return syncGroupsAndUsers(app.Id, d, m)
+}
+
+func resourceAppWsFederationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	app := buildAppWsFederation(d, m)
+	_, _, err := client.Application.UpdateApplication(d.Id(), app)
+
+	if err != nil {
+		return err
+	}
+
+	desiredStatus := d.Get("status").(string)
+	err = setAppStatus(d, client, app.Status, desiredStatus)
+
+	if err != nil {
+		return err
+	}
+
+	if err := handleAppGroupsAndUsers(app.Id, d, m); err != nil {
+		return err
+	}
+
+	if err := handleAppNotesAndLogo(d.Id(), d, m); err != nil {
+		return err
+	}
+
+	return resourceAppWsFederationRead(d, m)
+}
+
+func resourceAppWsFederationDelete(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	_, err := client.Application.DeactivateApplication(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Application.DeleteApplication(d.Id())
+
+	return err
+}
+
+func buildAppWsFederation(d *schema.ResourceData, m interface{}) *okta.WsFederationApplication {
+	// Abstracts away name and SignOnMode which are constant for this app type.
+	app := okta.NewWsFederationApplication()
+	app.Label = d.Get("label").(string)
+	replyOverride := d.Get("reply_override").(bool)
+
+	app.Settings = &okta.WsFederationApplicationSettings{
+		App: &okta.WsFederationApplicationSettingsApplication{
+			AttributeStatements:  d.Get("attribute_statements").(string),
+			AudienceRestriction:  d.Get("audience_restriction").(string),
+			AuthnContextClassRef: d.Get("authn_context_class_ref").(string),
+			GroupFilter:          d.Get("group_filter").(string),
+			GroupName:            d.Get("group_name").(string),
+			GroupValueFormat:     d.Get("group_value_format").(string),
+			NameIDFormat:         d.Get("name_id_format").(string),
+			Realm:                d.Get("realm").(string),
+			SiteURL:              d.Get("site_url").(string),
+			UsernameAttribute:    d.Get("username_attribute").(string),
+			WReplyOverride:       &replyOverride,
+			WReplyURL:            d.Get("reply_url").(string),
+		},
+	}
+	app.Visibility = buildVisibility(d)
+
+	return app
+}
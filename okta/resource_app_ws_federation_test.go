@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/okta/okta-sdk-golang/okta"
+)
+
+func TestAccOktaAppWsFederation(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appWsFederation)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appWsFederation)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(appWsFederation, createDoesAppExist(okta.NewWsFederationApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewWsFederationApplication())),
+					resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "site_url", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "reply_url", "https://example.com/sso/wsfed"),
+					resource.TestCheckResourceAttr(resourceName, "name_id_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, createDoesAppExist(okta.NewWsFederationApplication())),
+					resource.TestCheckResourceAttr(resourceName, "reply_url", "https://example.com/sso/wsfed-updated"),
+					resource.TestCheckResourceAttr(resourceName, "reply_override", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name_id_format", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"),
+					resource.TestCheckResourceAttr(resourceName, "username_attribute", "upn"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_statements", "givenname|${user.firstName}|,surname|${user.lastName}|"),
+					resource.TestCheckResourceAttr(resourceName, "group_value_format", "samAccountName"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
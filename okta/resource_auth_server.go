@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -44,7 +45,41 @@ func resourceAuthServer() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"AUTO", "MANUAL"}, false),
 				Default:      "AUTO",
-				Description:  "Credential rotation mode, in many cases you cannot set this to MANUAL, the API will ignore the value and you will get a perpetual diff. This should rarely be used.",
+				Description:  "Credential rotation mode. With MANUAL keys are only rotated when rotate_keys_trigger changes.",
+			},
+			"rotate_keys_trigger": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, any change to it rotates the signing keys of the authorization server.",
+			},
+			"keys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alg": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"x5t": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SHA-256 thumbprint of the key certificate.",
+						},
+					},
+				},
 			},
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -105,6 +140,11 @@ func resourceAuthServerCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(responseAuthServer.Id)
 
+	// The rotation mode is ignored on creation, it has to be set on a subsequent update or it would never converge
+	if err := ensureAuthServerRotationMode(d, m, responseAuthServer); err != nil {
+		return err
+	}
+
 	return resourceAuthServerRead(d, m)
 }
 
@@ -130,6 +170,10 @@ func resourceAuthServerRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("status", authServer.Status)
 	d.Set("issuer", authServer.Issuer)
 
+	if err := syncAuthServerKeys(d, m); err != nil {
+		return err
+	}
+
 	// Do not sync these unless the issuer mode is specified since it is an EA feature and is computed in some cases
 	if authServer.IssuerMode != "" {
 		d.Set("issuer_mode", authServer.IssuerMode)
@@ -145,11 +189,21 @@ func resourceAuthServerUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	authServer := buildAuthServer(d)
-	_, _, err := client.UpdateAuthorizationServer(d.Id(), *authServer, nil)
+	responseAuthServer, _, err := client.UpdateAuthorizationServer(d.Id(), *authServer, nil)
 	if err != nil {
 		return err
 	}
 
+	if err := ensureAuthServerRotationMode(d, m, responseAuthServer); err != nil {
+		return err
+	}
+
+	if d.HasChange("rotate_keys_trigger") {
+		if _, _, err := client.RotateAuthorizationServerKeys(d.Id()); err != nil {
+			return fmt.Errorf("failed to rotate keys of authorization server %s: %v", d.Id(), err)
+		}
+	}
+
 	return resourceAuthServerRead(d, m)
 }
 
@@ -163,6 +217,40 @@ func resourceAuthServerDelete(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
+func ensureAuthServerRotationMode(d *schema.ResourceData, m interface{}, authServer *AuthorizationServer) error {
+	mode := d.Get("credentials_rotation_mode").(string)
+	if authServer.Credentials == nil || authServer.Credentials.Signing == nil || authServer.Credentials.Signing.RotationMode == mode {
+		return nil
+	}
+
+	_, _, err := getSupplementFromMetadata(m).UpdateAuthorizationServer(d.Id(), *buildAuthServer(d), nil)
+	if err != nil {
+		return fmt.Errorf("failed to set credentials_rotation_mode to %s: %v", mode, err)
+	}
+
+	return nil
+}
+
+func syncAuthServerKeys(d *schema.ResourceData, m interface{}) error {
+	keys, _, err := getSupplementFromMetadata(m).ListAuthorizationServerKeys(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to list keys of authorization server %s: %v", d.Id(), err)
+	}
+
+	arr := make([]map[string]interface{}, len(keys))
+	for i, key := range keys {
+		arr[i] = map[string]interface{}{
+			"alg":    key.Alg,
+			"kid":    key.Kid,
+			"status": key.Status,
+			"use":    key.Use,
+			"x5t":    key.X5t,
+		}
+	}
+
+	return d.Set("keys", arr)
+}
+
 func fetchAuthServer(d *schema.ResourceData, m interface{}) (*AuthorizationServer, error) {
 	auth, resp, err := getSupplementFromMetadata(m).GetAuthorizationServer(d.Id())
 
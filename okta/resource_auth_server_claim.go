@@ -1,7 +1,9 @@
 package okta
 
 import (
+	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -9,12 +11,19 @@ import (
 
 func resourceAuthServerClaim() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceAuthServerClaimCreate,
+		Create:   withParentLock("auth_server_id", resourceAuthServerClaimCreate),
 		Exists:   resourceAuthServerClaimExists,
 		Read:     resourceAuthServerClaimRead,
-		Update:   resourceAuthServerClaimUpdate,
-		Delete:   resourceAuthServerClaimDelete,
+		Update:   withParentLock("auth_server_id", resourceAuthServerClaimUpdate),
+		Delete:   withParentLock("auth_server_id", resourceAuthServerClaimDelete),
 		Importer: createNestedResourceImporter([]string{"auth_server_id", "id"}),
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			if !d.NewValueKnown("value") {
+				return nil
+			}
+
+			return validateAuthServerClaimValue(d.Get("value_type").(string), d.Get("group_filter_type").(string), d.Get("value").(string))
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -35,8 +44,9 @@ func resourceAuthServerClaim() *schema.Resource {
 			},
 			"status": statusSchema,
 			"value": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Okta expression, or the group filter when value_type is GROUPS.",
 			},
 			"value_type": &schema.Schema{
 				Type:         schema.TypeString,
@@ -57,13 +67,44 @@ func resourceAuthServerClaim() *schema.Resource {
 			"group_filter_type": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"START_WITH", "EQUALS", "CONTAINS", "REGEX"}, false),
-				Description:  "Required when value_type is GROUPS",
+				ValidateFunc: validation.StringInSlice([]string{"STARTS_WITH", "START_WITH", "EQUALS", "CONTAINS", "REGEX"}, false),
+				StateFunc:    normalizeGroupFilterType,
+				Description:  "Required when value_type is GROUPS, how value is matched against group names. START_WITH is accepted as a deprecated spelling of STARTS_WITH.",
 			},
 		},
 	}
 }
 
+// Okta only reports a bad claim value with a generic 400, validate what we can locally. Okta's expression language is
+// much larger than the subset we can evaluate, so only outright syntax errors are rejected.
+func validateAuthServerClaimValue(valueType, groupFilterType, value string) error {
+	if valueType != "GROUPS" {
+		if groupFilterType != "" {
+			return fmt.Errorf("group_filter_type can only be set when value_type is GROUPS")
+		}
+
+		if _, err := parseExpression(value); err != nil {
+			if _, ok := err.(*unsupportedExpressionError); !ok {
+				return fmt.Errorf("value is not a valid Okta expression, %v", err)
+			}
+		}
+
+		return nil
+	}
+
+	if groupFilterType == "" {
+		return fmt.Errorf("group_filter_type is required when value_type is GROUPS")
+	}
+
+	if groupFilterType == "REGEX" {
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf("value is not a valid regular expression, %v", err)
+		}
+	}
+
+	return nil
+}
+
 func buildAuthServerClaim(d *schema.ResourceData) *AuthorizationServerClaim {
 	return &AuthorizationServerClaim{
 		Status:               d.Get("status").(string),
@@ -73,10 +114,19 @@ func buildAuthServerClaim(d *schema.ResourceData) *AuthorizationServerClaim {
 		AlwaysIncludeInToken: d.Get("always_include_in_token").(bool),
 		Name:                 d.Get("name").(string),
 		Conditions:           &ClaimConditions{Scopes: convertInterfaceToStringSetNullable(d.Get("scopes"))},
-		GroupFilterType:      d.Get("group_filter_type").(string),
+		GroupFilterType:      normalizeGroupFilterType(d.Get("group_filter_type")),
 	}
 }
 
+// START_WITH was accepted before the provider switched to the value Okta actually expects
+func normalizeGroupFilterType(val interface{}) string {
+	if filter := val.(string); filter != "START_WITH" {
+		return filter
+	}
+
+	return "STARTS_WITH"
+}
+
 func resourceAuthServerClaimCreate(d *schema.ResourceData, m interface{}) error {
 	authServerClaim := buildAuthServerClaim(d)
 	c := getSupplementFromMetadata(m)
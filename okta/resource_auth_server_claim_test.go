@@ -49,6 +49,7 @@ func TestAccOktaAuthServerClaimGroupType(t *testing.T) {
 	resourceName := fmt.Sprintf("%s.test", authServerClaim)
 	mgr := newFixtureManager(authServerClaim)
 	config := mgr.GetFixtures("basic_group.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_group_updated.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -66,6 +67,46 @@ func TestAccOktaAuthServerClaimGroupType(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "claim_type", "RESOURCE"),
 				),
 			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "group_filter_type", "REGEX"),
+					resource.TestCheckResourceAttr(resourceName, "value_type", "GROUPS"),
+					resource.TestCheckResourceAttr(resourceName, "value", "^Every.*"),
+					resource.TestCheckResourceAttr(resourceName, "claim_type", "IDENTITY"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+				),
+			},
 		},
 	})
 }
+
+func TestValidateAuthServerClaimValue(t *testing.T) {
+	tests := []struct {
+		valueType  string
+		filterType string
+		value      string
+		valid      bool
+	}{
+		{"EXPRESSION", "", "user.email", true},
+		{"EXPRESSION", "", "String.toLowerCase(user.firstName)", true},
+		{"EXPRESSION", "", "user.firstName + \" \" + user.lastName", true},
+		{"EXPRESSION", "", "(user.email", false},
+		{"EXPRESSION", "", "\"unterminated", false},
+		{"EXPRESSION", "EQUALS", "user.email", false},
+		{"GROUPS", "", "Everyone", false},
+		{"GROUPS", "STARTS_WITH", "app_", true},
+		{"GROUPS", "REGEX", "^app_.*$", true},
+		{"GROUPS", "REGEX", "^app_(.*$", false},
+	}
+
+	for _, test := range tests {
+		err := validateAuthServerClaimValue(test.valueType, test.filterType, test.value)
+		if test.valid && err != nil {
+			t.Errorf("expected %s %q to be valid, got %v", test.valueType, test.value, err)
+		} else if !test.valid && err == nil {
+			t.Errorf("expected %s %q with filter %q to be invalid", test.valueType, test.value, test.filterType)
+		}
+	}
+}
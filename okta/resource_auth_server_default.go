@@ -0,0 +1,137 @@
+package okta
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+)
+
+// The built-in authorization server cannot be created or deleted, this resource adopts it and manages its settings.
+// Settings that are not configured are left as they are in Okta.
+func resourceAuthServerDefault() *schema.Resource {
+	s := resourceAuthServer().Schema
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "default",
+		Description: "Name of the built-in authorization server to manage.",
+	}
+	for _, key := range []string{"audiences", "description", "issuer_mode", "credentials_rotation_mode"} {
+		s[key].Required = false
+		s[key].Optional = true
+		s[key].Computed = true
+		s[key].Default = nil
+	}
+
+	return &schema.Resource{
+		Create: resourceAuthServerDefaultCreate,
+		Exists: resourceAuthServerExists,
+		Read:   resourceAuthServerRead,
+		Update: resourceAuthServerDefaultUpdate,
+		Delete: resourceAuthServerDefaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: s,
+	}
+}
+
+func buildDefaultAuthServer(d *schema.ResourceData, existing *AuthorizationServer) *AuthorizationServer {
+	authServer := &AuthorizationServer{
+		Audiences:   existing.Audiences,
+		Description: existing.Description,
+		IssuerMode:  existing.IssuerMode,
+		Name:        existing.Name,
+	}
+	rotationMode := ""
+	if existing.Credentials != nil && existing.Credentials.Signing != nil {
+		rotationMode = existing.Credentials.Signing.RotationMode
+	}
+
+	if audiences, ok := d.GetOk("audiences"); ok {
+		authServer.Audiences = convertInterfaceToStringSet(audiences)
+	}
+	if description, ok := d.GetOk("description"); ok {
+		authServer.Description = description.(string)
+	}
+	if issuerMode, ok := d.GetOk("issuer_mode"); ok {
+		authServer.IssuerMode = issuerMode.(string)
+	}
+	if mode, ok := d.GetOk("credentials_rotation_mode"); ok {
+		rotationMode = mode.(string)
+	}
+	authServer.Credentials = &AuthServerCredentials{
+		Signing: &okta.ApplicationCredentialsSigning{RotationMode: rotationMode},
+	}
+
+	return authServer
+}
+
+func resourceAuthServerDefaultCreate(d *schema.ResourceData, m interface{}) error {
+	name := d.Get("name").(string)
+	authServer, err := getSupplementFromMetadata(m).FindAuthServer(name, &query.Params{})
+	if err != nil {
+		return err
+	}
+	if authServer == nil {
+		return fmt.Errorf("No authorization server found with provided name %s", name)
+	}
+
+	d.SetId(authServer.Id)
+
+	if authServer.Status != d.Get("status").(string) {
+		if err := handleAuthServerLifecycle(d, m); err != nil {
+			return err
+		}
+	}
+
+	if err := updateDefaultAuthServer(d, m, authServer); err != nil {
+		return err
+	}
+
+	return resourceAuthServerRead(d, m)
+}
+
+func resourceAuthServerDefaultUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("status") {
+		if err := handleAuthServerLifecycle(d, m); err != nil {
+			return err
+		}
+	}
+
+	authServer, err := fetchAuthServer(d, m)
+	if err != nil {
+		return err
+	}
+	if authServer == nil {
+		return fmt.Errorf("authorization server %s no longer exists", d.Id())
+	}
+
+	if err := updateDefaultAuthServer(d, m, authServer); err != nil {
+		return err
+	}
+
+	if d.HasChange("rotate_keys_trigger") {
+		if _, _, err := getSupplementFromMetadata(m).RotateAuthorizationServerKeys(d.Id()); err != nil {
+			return fmt.Errorf("failed to rotate keys of authorization server %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceAuthServerRead(d, m)
+}
+
+func updateDefaultAuthServer(d *schema.ResourceData, m interface{}, existing *AuthorizationServer) error {
+	_, _, err := getSupplementFromMetadata(m).UpdateAuthorizationServer(d.Id(), *buildDefaultAuthServer(d, existing), nil)
+
+	return err
+}
+
+func resourceAuthServerDefaultDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Authorization server %s is built-in, removing it from state without deleting it", d.Get("name").(string))
+
+	return nil
+}
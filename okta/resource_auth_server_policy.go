@@ -1,19 +1,28 @@
 package okta
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/okta/okta-sdk-golang/okta"
 )
 
 func resourceAuthServerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceAuthServerPolicyCreate,
+		Create:   withParentLock("auth_server_id", resourceAuthServerPolicyCreate),
 		Exists:   resourceAuthServerPolicyExists,
 		Read:     resourceAuthServerPolicyRead,
-		Update:   resourceAuthServerPolicyUpdate,
-		Delete:   resourceAuthServerPolicyDelete,
+		Update:   withParentLock("auth_server_id", resourceAuthServerPolicyUpdate),
+		Delete:   withParentLock("auth_server_id", resourceAuthServerPolicyDelete),
 		Importer: createNestedResourceImporter([]string{"auth_server_id", "id"}),
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			clients := convertInterfaceToStringSet(d.Get("client_whitelist"))
+			if contains(clients, "ALL_CLIENTS") && len(clients) > 1 {
+				return fmt.Errorf("client_whitelist cannot contain ALL_CLIENTS along with specific client IDs")
+			}
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": &schema.Schema{
@@ -43,7 +52,7 @@ func resourceAuthServerPolicy() *schema.Resource {
 			"client_whitelist": &schema.Schema{
 				Type:        schema.TypeSet,
 				Required:    true,
-				Description: "Use [\"ALL_CLIENTS\"] when unsure.",
+				Description: "Use [\"ALL_CLIENTS\"] when unsure, otherwise the IDs of okta_app_oauth applications.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
@@ -66,6 +75,10 @@ func buildAuthServerPolicy(d *schema.ResourceData) *AuthorizationServerPolicy {
 }
 
 func resourceAuthServerPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if err := validateClientWhitelist(d, m); err != nil {
+		return err
+	}
+
 	authServerPolicy := buildAuthServerPolicy(d)
 	c := getSupplementFromMetadata(m)
 	responseAuthServerPolicy, _, err := c.CreateAuthorizationServerPolicy(d.Get("auth_server_id").(string), *authServerPolicy, nil)
@@ -100,6 +113,12 @@ func resourceAuthServerPolicyRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceAuthServerPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("client_whitelist") {
+		if err := validateClientWhitelist(d, m); err != nil {
+			return err
+		}
+	}
+
 	authServerPolicy := buildAuthServerPolicy(d)
 	c := getSupplementFromMetadata(m)
 	_, _, err := c.UpdateAuthorizationServerPolicy(d.Get("auth_server_id").(string), d.Id(), *authServerPolicy, nil)
@@ -116,6 +135,28 @@ func resourceAuthServerPolicyDelete(d *schema.ResourceData, m interface{}) error
 	return err
 }
 
+// The API accepts any client ID and the policy silently never applies, so ensure they are all OIDC applications.
+func validateClientWhitelist(d *schema.ResourceData, m interface{}) error {
+	for _, id := range convertInterfaceToStringSet(d.Get("client_whitelist")) {
+		if id == "ALL_CLIENTS" {
+			continue
+		}
+
+		app := okta.NewApplication()
+		if err := fetchAppById(id, m, app); err != nil {
+			return err
+		}
+
+		if app.Id == "" {
+			return fmt.Errorf("client_whitelist: application %s does not exist", id)
+		} else if app.SignOnMode != "OPENID_CONNECT" {
+			return fmt.Errorf("client_whitelist: application %s is not an OAuth application, sign on mode is %s", id, app.SignOnMode)
+		}
+	}
+
+	return nil
+}
+
 func fetchAuthServerPolicy(d *schema.ResourceData, m interface{}) (*AuthorizationServerPolicy, error) {
 	c := getSupplementFromMetadata(m)
 	auth, resp, err := c.GetAuthorizationServerPolicy(d.Get("auth_server_id").(string), d.Id(), AuthorizationServerPolicy{})
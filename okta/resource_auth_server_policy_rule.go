@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -9,12 +10,13 @@ import (
 
 func resourceAuthServerPolicyRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceAuthServerPolicyRuleCreate,
-		Exists:   resourceAuthServerPolicyRuleExists,
-		Read:     resourceAuthServerPolicyRuleRead,
-		Update:   resourceAuthServerPolicyRuleUpdate,
-		Delete:   resourceAuthServerPolicyRuleDelete,
-		Importer: createNestedResourceImporter([]string{"auth_server_id", "policy_id", "id"}),
+		Create:        withParentLock("auth_server_id", resourceAuthServerPolicyRuleCreate),
+		Exists:        resourceAuthServerPolicyRuleExists,
+		Read:          resourceAuthServerPolicyRuleRead,
+		Update:        withParentLock("auth_server_id", resourceAuthServerPolicyRuleUpdate),
+		Delete:        withParentLock("auth_server_id", resourceAuthServerPolicyRuleDelete),
+		Importer:      createNestedResourceImporter([]string{"auth_server_id", "policy_id", "id"}),
+		CustomizeDiff: validateTokenLifetimes,
 
 		Schema: addPeopleAssignments(map[string]*schema.Schema{
 			"type": &schema.Schema{
@@ -65,6 +67,9 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 			"refresh_token_lifetime_minutes": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
+				// Unlimited or 10 mins - 5 years
+				ValidateFunc: validateRefreshTokenLifetime,
+				Description:  "Refresh token lifetime, leave unset for an unlimited lifetime.",
 			},
 			"refresh_token_window_minutes": &schema.Schema{
 				Type:     schema.TypeInt,
@@ -81,6 +86,32 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 	}
 }
 
+func validateRefreshTokenLifetime(val interface{}, key string) ([]string, []error) {
+	lifetime := val.(int)
+	if lifetime != 0 && (lifetime < 10 || lifetime > 2628000) {
+		return nil, []error{fmt.Errorf("%s must be between 10 and 2628000 minutes, got %d", key, lifetime)}
+	}
+
+	return nil, nil
+}
+
+// Okta rejects token lifetimes that are inconsistent with each other, better to find out at plan time.
+func validateTokenLifetimes(d *schema.ResourceDiff, m interface{}) error {
+	access := d.Get("access_token_lifetime_minutes").(int)
+	window := d.Get("refresh_token_window_minutes").(int)
+	lifetime := d.Get("refresh_token_lifetime_minutes").(int)
+
+	if window < access {
+		return fmt.Errorf("refresh_token_window_minutes (%d) must be greater than or equal to access_token_lifetime_minutes (%d)", window, access)
+	}
+
+	if lifetime != 0 && lifetime < window {
+		return fmt.Errorf("refresh_token_lifetime_minutes (%d) must be greater than or equal to refresh_token_window_minutes (%d)", lifetime, window)
+	}
+
+	return nil
+}
+
 func buildAuthServerPolicyRule(d *schema.ResourceData) *AuthorizationServerPolicyRule {
 	var hook *AuthServerInlineHook
 
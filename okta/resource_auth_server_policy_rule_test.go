@@ -32,6 +32,8 @@ func TestAccOktaAuthServerPolicyRuleCreate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "name", "test_updated"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_window_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_lifetime_minutes", "1440"),
 				),
 			},
 		},
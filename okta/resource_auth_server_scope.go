@@ -1,6 +1,8 @@
 package okta
 
 import (
+	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -9,18 +11,30 @@ import (
 
 func resourceAuthServerScope() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceAuthServerScopeCreate,
+		Create:   withParentLock("auth_server_id", resourceAuthServerScopeCreate),
 		Exists:   resourceAuthServerScopeExists,
 		Read:     resourceAuthServerScopeRead,
-		Update:   resourceAuthServerScopeUpdate,
-		Delete:   resourceAuthServerScopeDelete,
+		Update:   withParentLock("auth_server_id", resourceAuthServerScopeUpdate),
+		Delete:   withParentLock("auth_server_id", resourceAuthServerScopeDelete),
 		Importer: createNestedResourceImporter([]string{"auth_server_id", "id"}),
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			if d.Id() == "" || !d.HasChange("name") {
+				return nil
+			}
+
+			oldName, newName := d.GetChange("name")
+			if contains(reservedScopes, oldName.(string)) || contains(reservedScopes, newName.(string)) {
+				return fmt.Errorf("cannot rename scope %s to %s, reserved system scopes cannot be renamed", oldName, newName)
+			}
+
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Auth server scope name",
+				Description: "Auth server scope name. Reserved system scopes, such as openid, are adopted rather than created.",
 			},
 			"auth_server_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -31,11 +45,28 @@ func resourceAuthServerScope() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"consent": &schema.Schema{
+			"display_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "IMPLICIT",
-				Description: "EA Feature and thus it is simply ignored if the feature is off",
+				Description: "Name of the scope shown on the consent screen.",
+			},
+			"consent": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IMPLICIT",
+				Description:  "EA Feature and thus it is simply ignored if the feature is off",
+				ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "IMPLICIT", "FLEXIBLE"}, false),
+			},
+			"default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the scope is granted when a client does not request any scopes.",
+			},
+			"system": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether this is a reserved system scope, these are never deleted.",
 			},
 			"metadata_publish": &schema.Schema{
 				Type:         schema.TypeString,
@@ -51,7 +82,9 @@ func resourceAuthServerScope() *schema.Resource {
 func buildAuthServerScope(d *schema.ResourceData) *AuthorizationServerScope {
 	return &AuthorizationServerScope{
 		Consent:         d.Get("consent").(string),
+		Default:         d.Get("default").(bool),
 		Description:     d.Get("description").(string),
+		DisplayName:     d.Get("display_name").(string),
 		MetadataPublish: d.Get("metadata_publish").(string),
 		Name:            d.Get("name").(string),
 	}
@@ -60,6 +93,11 @@ func buildAuthServerScope(d *schema.ResourceData) *AuthorizationServerScope {
 func resourceAuthServerScopeCreate(d *schema.ResourceData, m interface{}) error {
 	authServerScope := buildAuthServerScope(d)
 	c := getSupplementFromMetadata(m)
+
+	if contains(reservedScopes, authServerScope.Name) {
+		return adoptSystemScope(d, m, authServerScope)
+	}
+
 	responseAuthServerScope, _, err := c.CreateAuthorizationServerScope(d.Get("auth_server_id").(string), *authServerScope, nil)
 	if err != nil {
 		return err
@@ -84,7 +122,10 @@ func resourceAuthServerScopeRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("name", authServerScope.Name)
 	d.Set("description", authServerScope.Description)
+	d.Set("display_name", authServerScope.DisplayName)
 	d.Set("metadata_publish", authServerScope.MetadataPublish)
+	d.Set("default", authServerScope.Default)
+	d.Set("system", authServerScope.System)
 
 	if authServerScope.Consent != "" {
 		d.Set("consent", authServerScope.Consent)
@@ -105,11 +146,39 @@ func resourceAuthServerScopeUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceAuthServerScopeDelete(d *schema.ResourceData, m interface{}) error {
+	if d.Get("system").(bool) || contains(reservedScopes, d.Get("name").(string)) {
+		log.Printf("[INFO] Scope %s is a reserved system scope, removing it from state without deleting it", d.Get("name").(string))
+		return nil
+	}
+
 	_, err := getSupplementFromMetadata(m).DeleteAuthorizationServerScope(d.Get("auth_server_id").(string), d.Id())
 
 	return err
 }
 
+// System scopes already exist on every auth server, creating them fails so take over the existing scope instead.
+func adoptSystemScope(d *schema.ResourceData, m interface{}, scope *AuthorizationServerScope) error {
+	authServerId := d.Get("auth_server_id").(string)
+	c := getSupplementFromMetadata(m)
+	scopes, _, err := c.ListAuthorizationServerScopes(authServerId)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range scopes {
+		if existing.Name == scope.Name {
+			if _, _, err := c.UpdateAuthorizationServerScope(authServerId, existing.Id, *scope, nil); err != nil {
+				return err
+			}
+			d.SetId(existing.Id)
+
+			return resourceAuthServerScopeRead(d, m)
+		}
+	}
+
+	return fmt.Errorf("reserved scope %s does not exist on auth server %s", scope.Name, authServerId)
+}
+
 func fetchAuthServerScope(d *schema.ResourceData, m interface{}) (*AuthorizationServerScope, error) {
 	c := getSupplementFromMetadata(m)
 	auth, resp, err := c.GetAuthorizationServerScope(d.Get("auth_server_id").(string), d.Id(), AuthorizationServerScope{})
@@ -31,9 +31,34 @@ func TestAccOktaAuthServerScopeCrud(t *testing.T) {
 			{
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "consent", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "consent", "FLEXIBLE"),
 					resource.TestCheckResourceAttr(resourceName, "name", "test:something"),
 					resource.TestCheckResourceAttr(resourceName, "description", "test_updated"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Something"),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaAuthServerScopeSystem(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServerScope)
+	mgr := newFixtureManager(authServerScope)
+	config := mgr.GetFixtures("system.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "consent", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "name", "offline_access"),
+					resource.TestCheckResourceAttr(resourceName, "system", "true"),
 				),
 			},
 		},
@@ -49,6 +49,7 @@ func TestAccOktaAuthServerCrud(t *testing.T) {
 	mgr := newFixtureManager("okta_auth_server")
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	manualConfig := mgr.GetFixtures("manual_rotation.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -73,6 +74,16 @@ func TestAccOktaAuthServerCrud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "audiences.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "credentials_rotation_mode", "AUTO"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.#"),
+				),
+			},
+			{
+				Config: manualConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "credentials_rotation_mode", "MANUAL"),
+					resource.TestCheckResourceAttr(resourceName, "rotate_keys_trigger", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.kid"),
 				),
 			},
 		},
@@ -1,50 +1,48 @@
 package okta
 
 import (
-	"github.com/articulate/oktasdk-go/okta"
+	"fmt"
+	"net/http"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// Secrets are write-only, Okta never returns them so only a digest is kept in state to detect config changes.
+func secretSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		StateFunc:   hashSecret,
+		Description: description,
+	}
+}
+
 // Predefined second authentication factors. They must be activated in order to use them in MFA policies.
 // This is not your standard resource as each factor provider is predefined and the create function simply puts it in
-// terraform state and activates it. Currently the API is in Beta and it only allows lifecycle interactions, and
-// no ability to configure them but the resource was built with future expansion in mind. Also keep in mind this
+// terraform state, configures and activates it. Providers that need credentials (Duo, RSA SecurID, Symantec VIP,
+// YubiKey, third party telephony) are configured with a block matching the provider. Also keep in mind this
 // is an account level resource.
 func resourceFactor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceFactorPut,
-		Read:   resourceFactorRead,
-		Update: resourceFactorPut,
-		Exists: resourceFactorExists,
-		Delete: resourceFactorDelete,
+		Create:        resourceFactorPut,
+		Read:          resourceFactorRead,
+		Update:        resourceFactorPut,
+		Exists:        resourceFactorExists,
+		Delete:        resourceFactorDelete,
+		CustomizeDiff: validateFactorSettings,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"provider_id": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice(
-					[]string{
-						okta.DuoFactor,
-						okta.FidoU2fFactor,
-						okta.FidoWebauthnFactor,
-						okta.GoogleOtpFactor,
-						okta.OktaCallFactor,
-						okta.OktaOtpFactor,
-						okta.OktaPushFactor,
-						okta.OktaQuestionFactor,
-						okta.OktaSmsFactor,
-						okta.RsaTokenFactor,
-						okta.SymantecVipFactor,
-						okta.YubikeyTokenFactor,
-					},
-					false,
-				),
-				Description: "Factor provider ID",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(remove(factorCatalog, "okta_password"), false),
+				Description:  "Factor provider ID",
+				ForceNew:     true,
 			},
 			"active": {
 				Type:        schema.TypeBool,
@@ -52,45 +50,188 @@ func resourceFactor() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"duo": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Duo Security integration, only valid for the duo provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"integration_key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"secret_key": secretSchema("Duo secret key."),
+						"api_host": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"rsa": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "RSA SecurID authentication manager, only valid for the rsa_token provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_url": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIsURL,
+						},
+						"client_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_key": secretSchema("RSA SecurID REST API access key."),
+					},
+				},
+			},
+			"symantec_vip": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Symantec VIP credentials, only valid for the symantec_vip provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate":          secretSchema("PEM encoded Symantec VIP client certificate and private key."),
+						"certificate_password": secretSchema("Password protecting the client certificate."),
+					},
+				},
+			},
+			"telephony": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "SMS and voice call provider, only valid for the okta_sms and okta_call providers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"OKTA", "TWILIO"}, false),
+						},
+						"account_sid": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"auth_token": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+							StateFunc: hashSecret,
+						},
+						"from_number": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"yubikey_seed": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "YubiKey OTP seeds to upload, only valid for the yubikey_token provider. Seeds cannot be removed once uploaded.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"serial_number": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"public_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"private_id": secretSchema("YubiKey private ID."),
+						"aes_key":    secretSchema("YubiKey AES key."),
+					},
+				},
+			},
 		},
 	}
 }
 
+// Provider specific blocks and the providers they apply to
+var factorSettingsProviders = map[string][]string{
+	"duo":          []string{"duo"},
+	"rsa":          []string{"rsa_token"},
+	"symantec_vip": []string{"symantec_vip"},
+	"telephony":    []string{"okta_call", "okta_sms"},
+	"yubikey_seed": []string{"yubikey_token"},
+}
+
+func validateFactorSettings(d *schema.ResourceDiff, m interface{}) error {
+	id := d.Get("provider_id").(string)
+
+	for key, providers := range factorSettingsProviders {
+		if len(d.Get(key).([]interface{})) > 0 && !contains(providers, id) {
+			return fmt.Errorf("%s can only be configured for provider %s, got %s", key, providers, id)
+		}
+	}
+
+	return nil
+}
+
 func resourceFactorExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	factor, err := findFactor(d, m)
+	factor, err := fetchFactor(d, m)
 
 	return err == nil && factor != nil, err
 }
 
 func resourceFactorDelete(d *schema.ResourceData, m interface{}) error {
 	var err error
-	client := getClientFromMetadata(m)
 
 	if d.Get("active").(bool) {
-		_, _, err = client.Org.DeactivateFactor(d.Id())
+		_, err = getSupplementFromMetadata(m).DeactivateOrgFactor(d.Id())
 	}
 
 	return err
 }
 
 func resourceFactorRead(d *schema.ResourceData, m interface{}) error {
-	factor, err := findFactor(d, m)
+	factor, err := fetchFactor(d, m)
 	if err != nil {
 		return err
 	}
 
+	if factor == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("active", factor.Status == "ACTIVE")
 	d.Set("provider_id", factor.Id)
 
-	return nil
+	return setNonPrimitives(d, flattenFactorSettings(d, factor.Settings))
 }
 
 func resourceFactorPut(d *schema.ResourceData, m interface{}) error {
-	factor, err := findFactor(d, m)
+	id := d.Get("provider_id").(string)
+	// The ID is the provider ID which allows importing and directly fetching it.
+	d.SetId(id)
+
+	factor, err := fetchFactor(d, m)
 	if err != nil {
 		return err
 	}
 
+	if factor == nil {
+		return fmt.Errorf("factor provider %s is not available in this org", id)
+	}
+
+	// Providers must be configured before they can be activated
+	if settings := buildFactorSettings(d); settings != nil {
+		if _, _, err := getSupplementFromMetadata(m).UpdateOrgFactor(id, OrgFactor{Settings: settings}); err != nil {
+			return err
+		}
+	}
+
+	if err := uploadYubikeySeeds(d, m); err != nil {
+		return err
+	}
+
 	// To avoid API errors we check downstream status
 	if statusMismatch(d, factor) {
 		err := activateFactor(d, m)
@@ -98,47 +239,142 @@ func resourceFactorPut(d *schema.ResourceData, m interface{}) error {
 			return err
 		}
 	}
-	d.SetId(d.Get("provider_id").(string))
 
 	return resourceFactorRead(d, m)
 }
 
 func activateFactor(d *schema.ResourceData, m interface{}) error {
 	var err error
-	client := getClientFromMetadata(m)
+	client := getSupplementFromMetadata(m)
 	id := d.Get("provider_id").(string)
 
 	if d.Get("active").(bool) {
-		_, _, err = client.Org.ActivateFactor(id)
+		_, err = client.ActivateOrgFactor(id)
 	} else {
-		_, _, err = client.Org.DeactivateFactor(id)
+		_, err = client.DeactivateOrgFactor(id)
 	}
 
 	return err
 }
 
-// This API is in Beta hence the inability to do a single get. I must list then find.
-// Fear is clearly not a factor for me.
-func findFactor(d *schema.ResourceData, m interface{}) (*okta.Factor, error) {
-	client := getClientFromMetadata(m)
-	factorList, _, err := client.Org.ListFactors()
+func buildFactorSettings(d *schema.ResourceData) *OrgFactorSettings {
+	settings := &OrgFactorSettings{}
 
-	if err != nil {
-		return nil, err
+	if _, ok := d.GetOk("duo"); ok {
+		settings.Duo = &DuoFactorSettings{
+			ApiHost:        d.Get("duo.0.api_host").(string),
+			IntegrationKey: d.Get("duo.0.integration_key").(string),
+			SecretKey:      d.Get("duo.0.secret_key").(string),
+		}
+	} else if _, ok := d.GetOk("rsa"); ok {
+		settings.Rsa = &RsaFactorSettings{
+			AccessKey: d.Get("rsa.0.access_key").(string),
+			ApiUrl:    d.Get("rsa.0.api_url").(string),
+			ClientId:  d.Get("rsa.0.client_id").(string),
+		}
+	} else if _, ok := d.GetOk("symantec_vip"); ok {
+		settings.SymantecVip = &SymantecVipFactorSettings{
+			Certificate:         d.Get("symantec_vip.0.certificate").(string),
+			CertificatePassword: d.Get("symantec_vip.0.certificate_password").(string),
+		}
+	} else if _, ok := d.GetOk("telephony"); ok {
+		settings.Telephony = &TelephonyFactorSettings{
+			AccountSid: d.Get("telephony.0.account_sid").(string),
+			AuthToken:  d.Get("telephony.0.auth_token").(string),
+			FromNumber: d.Get("telephony.0.from_number").(string),
+			Provider:   d.Get("telephony.0.provider").(string),
+		}
+	} else {
+		return nil
 	}
 
-	id := d.Get("provider_id").(string)
+	// Only push settings when they change, an unchanged config would resend secrets on every status toggle.
+	if !d.IsNewResource() && !d.HasChange("duo") && !d.HasChange("rsa") && !d.HasChange("symantec_vip") && !d.HasChange("telephony") {
+		return nil
+	}
 
-	for _, f := range factorList {
-		if f.Id == id {
-			return f, nil
-		}
+	return settings
+}
+
+// Okta does not return secrets, only their digest is kept in state. Symantec VIP certificates and YubiKey seeds are
+// not returned at all, they are carried over as configured.
+func flattenFactorSettings(d *schema.ResourceData, settings *OrgFactorSettings) map[string]interface{} {
+	flattened := map[string]interface{}{}
+
+	if settings != nil && settings.Duo != nil {
+		flattened["duo"] = []interface{}{map[string]interface{}{
+			"api_host":        settings.Duo.ApiHost,
+			"integration_key": settings.Duo.IntegrationKey,
+			"secret_key":      secretState(d, "duo.0.secret_key"),
+		}}
+	}
+
+	if settings != nil && settings.Rsa != nil {
+		flattened["rsa"] = []interface{}{map[string]interface{}{
+			"api_url":    settings.Rsa.ApiUrl,
+			"client_id":  settings.Rsa.ClientId,
+			"access_key": secretState(d, "rsa.0.access_key"),
+		}}
+	}
+
+	if settings != nil && settings.Telephony != nil {
+		flattened["telephony"] = []interface{}{map[string]interface{}{
+			"account_sid": settings.Telephony.AccountSid,
+			"auth_token":  secretState(d, "telephony.0.auth_token"),
+			"from_number": settings.Telephony.FromNumber,
+			"provider":    settings.Telephony.Provider,
+		}}
+	}
+
+	if len(d.Get("symantec_vip").([]interface{})) > 0 {
+		flattened["symantec_vip"] = []interface{}{map[string]interface{}{
+			"certificate":          secretState(d, "symantec_vip.0.certificate"),
+			"certificate_password": secretState(d, "symantec_vip.0.certificate_password"),
+		}}
+	}
+
+	var seeds []interface{}
+	for i, raw := range d.Get("yubikey_seed").([]interface{}) {
+		seed := raw.(map[string]interface{})
+		seeds = append(seeds, map[string]interface{}{
+			"serial_number": seed["serial_number"],
+			"public_id":     seed["public_id"],
+			"private_id":    secretState(d, fmt.Sprintf("yubikey_seed.%d.private_id", i)),
+			"aes_key":       secretState(d, fmt.Sprintf("yubikey_seed.%d.aes_key", i)),
+		})
+	}
+	if len(seeds) > 0 {
+		flattened["yubikey_seed"] = seeds
+	}
+
+	return flattened
+}
+
+// The StateFunc of attributes nested in blocks is not applied when the state is written, the secret would end up in
+// state as configured. While it changes the value is the configured secret, otherwise it is the digest in state.
+func secretState(d *schema.ResourceData, key string) string {
+	if d.HasChange(key) {
+		return hashSecret(d.Get(key))
 	}
 
-	return nil, nil
+	return d.Get(key).(string)
 }
 
-func statusMismatch(d *schema.ResourceData, factor *okta.Factor) bool {
+func fetchFactor(d *schema.ResourceData, m interface{}) (*OrgFactor, error) {
+	id := d.Id()
+	if id == "" {
+		id = d.Get("provider_id").(string)
+	}
+	factor, resp, err := getSupplementFromMetadata(m).GetOrgFactor(id)
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	return factor, err
+}
+
+func statusMismatch(d *schema.ResourceData, factor *OrgFactor) bool {
 	status := d.Get("active").(bool)
 
 	// I miss ternary operators
@@ -148,3 +384,37 @@ func statusMismatch(d *schema.ResourceData, factor *okta.Factor) bool {
 
 	return status
 }
+
+// Seeds cannot be listed or removed, only the ones added since the last apply are uploaded.
+func uploadYubikeySeeds(d *schema.ResourceData, m interface{}) error {
+	if !d.HasChange("yubikey_seed") {
+		return nil
+	}
+
+	client := getSupplementFromMetadata(m)
+	oldSeeds, _ := d.GetChange("yubikey_seed")
+	var uploaded []string
+	for _, raw := range oldSeeds.([]interface{}) {
+		uploaded = append(uploaded, raw.(map[string]interface{})["serial_number"].(string))
+	}
+
+	for i, raw := range d.Get("yubikey_seed").([]interface{}) {
+		seed := raw.(map[string]interface{})
+		serial := seed["serial_number"].(string)
+		if contains(uploaded, serial) {
+			continue
+		}
+
+		_, err := client.UploadYubikeySeed(YubikeySeed{
+			AesKey:       d.Get(fmt.Sprintf("yubikey_seed.%d.aes_key", i)).(string),
+			PrivateId:    d.Get(fmt.Sprintf("yubikey_seed.%d.private_id", i)).(string),
+			PublicId:     seed["public_id"].(string),
+			SerialNumber: serial,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upload YubiKey seed %s: %v", serial, err)
+		}
+	}
+
+	return nil
+}
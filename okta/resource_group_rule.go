@@ -1,9 +1,12 @@
 package okta
 
 import (
-	"github.com/okta/okta-sdk-golang/okta"
+	"fmt"
 	"net/http"
 
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/query"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -26,8 +29,8 @@ func resourceGroupRule() *schema.Resource {
 				Type:     schema.TypeSet,
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				// Actions cannot be updated even on a deactivated rule
-				ForceNew: true,
+				// Actions cannot be updated even on a deactivated rule, changes are handled by replacing the rule
+				// in place, see replaceGroupRule.
 			},
 			"expression_type": &schema.Schema{
 				Type:     schema.TypeString,
@@ -39,6 +42,12 @@ func resourceGroupRule() *schema.Resource {
 				Required: true,
 			},
 			"status": statusSchema,
+			"remove_assigned_users": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove users added by this rule from the assigned groups when the rule is destroyed.",
+			},
 		},
 	}
 }
@@ -116,6 +125,14 @@ func resourceGroupRuleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceGroupRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("group_assignments") {
+		if err := replaceGroupRule(d, m); err != nil {
+			return err
+		}
+
+		return resourceGroupRuleRead(d, m)
+	}
+
 	desiredStatus := d.Get("status").(string)
 	// Only inactive rules can be changed, thus we should handle this first
 	if d.HasChange("status") {
@@ -168,11 +185,80 @@ func resourceGroupRuleDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	_, err := client.Group.DeleteRule(d.Id(), nil)
+	_, err := client.Group.DeleteRule(d.Id(), query.NewQueryParams(query.WithRemoveUsers(d.Get("remove_assigned_users").(bool))))
 
 	return err
 }
 
+// Actions cannot be updated even on a deactivated rule. Destroying and recreating the rule makes Okta remove and then
+// re-add every member, so instead the old rule is deactivated and renamed out of the way, the replacement is created
+// and activated, and finally the old rule is deleted while keeping the memberships it granted. Users in groups that are
+// no longer assigned keep their membership, just as they would when deleting the rule with remove_assigned_users unset.
+func replaceGroupRule(d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	oldId := d.Id()
+	oldRule, _, err := client.Group.GetRule(oldId)
+	if err != nil {
+		return err
+	}
+	wasActive := oldRule.Status == "ACTIVE"
+
+	// Deactivating a rule does not remove the users it assigned
+	if wasActive {
+		if _, err := client.Group.DeactivateRule(oldId); err != nil {
+			return err
+		}
+	}
+
+	// Rule names are unique, the old one has to step aside. Okta caps rule names at 50 characters.
+	originalName := oldRule.Name
+	oldRule.Name = fmt.Sprintf("Replaced by Terraform %s", oldId)
+	if _, _, err := client.Group.UpdateRule(oldId, *oldRule); err != nil {
+		return err
+	}
+
+	newRule, _, err := client.Group.CreateRule(*buildGroupRule(d))
+	if err != nil {
+		return restoreGroupRule(m, oldRule, originalName, wasActive, err)
+	}
+	d.SetId(newRule.Id)
+
+	if err := handleGroupRuleLifecycle(d, m); err != nil {
+		// The replacement never granted anything, it is discarded and the old rule put back in its place
+		client.Group.DeactivateRule(newRule.Id)
+		if _, delErr := client.Group.DeleteRule(newRule.Id, query.NewQueryParams(query.WithRemoveUsers(false))); delErr != nil {
+			return fmt.Errorf("failed to activate replacement group rule %s: %v, failed to delete it: %v, the replaced rule %s is left deactivated as %q", newRule.Id, err, delErr, oldId, oldRule.Name)
+		}
+		d.SetId(oldId)
+
+		return restoreGroupRule(m, oldRule, originalName, wasActive, err)
+	}
+
+	if _, err := client.Group.DeleteRule(oldId, query.NewQueryParams(query.WithRemoveUsers(false))); err != nil {
+		return fmt.Errorf("group rule %s was replaced by %s but could not be deleted, it is left deactivated as %q and must be deleted manually: %v", oldId, newRule.Id, oldRule.Name, err)
+	}
+
+	return nil
+}
+
+// Best effort at putting the old rule back as it was when its replacement could not be created or activated
+func restoreGroupRule(m interface{}, rule *okta.GroupRule, name string, active bool, cause error) error {
+	client := getOktaClientFromMetadata(m)
+	rule.Name = name
+
+	if _, _, err := client.Group.UpdateRule(rule.Id, *rule); err != nil {
+		return fmt.Errorf("failed to replace group rule: %v, failed to restore rule %s: %v", cause, rule.Id, err)
+	}
+
+	if active {
+		if _, err := client.Group.ActivateRule(rule.Id); err != nil {
+			return fmt.Errorf("failed to replace group rule: %v, failed to reactivate rule %s: %v", cause, rule.Id, err)
+		}
+	}
+
+	return cause
+}
+
 func fetchGroupRule(d *schema.ResourceData, m interface{}) (*okta.GroupRule, error) {
 	g, resp, err := getOktaClientFromMetadata(m).Group.GetRule(d.Id())
 
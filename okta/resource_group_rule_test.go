@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/okta/okta-sdk-golang/okta/query"
 )
 
@@ -41,6 +42,7 @@ func TestAccOktaGroupRuleCrud(t *testing.T) {
 	groupUpdate := mgr.GetFixtures("basic_group_update.tf", ri, t)
 	deactivated := mgr.GetFixtures("basic_deactivated.tf", ri, t)
 	name := buildResourceName(ri)
+	var replacedId string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -61,13 +63,17 @@ func TestAccOktaGroupRuleCrud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					saveGroupRuleId(resourceName, &replacedId),
 				),
 			},
 			{
+				// Changing the assignments replaces the rule, the old one must be gone
 				Config: groupUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "group_assignments.#", "1"),
+					ensureGroupRuleReplaced(resourceName, &replacedId),
 				),
 			},
 			{
@@ -75,8 +81,43 @@ func TestAccOktaGroupRuleCrud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "remove_assigned_users", "true"),
 				),
 			},
 		},
 	})
 }
+
+func saveGroupRuleId(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func ensureGroupRuleReplaced(name string, replacedId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		if rs.Primary.ID == *replacedId {
+			return fmt.Errorf("expected group rule %s to be replaced", *replacedId)
+		}
+
+		_, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).Group.GetRule(*replacedId)
+		if resp != nil && is404(resp.StatusCode) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("replaced group rule %s still exists", *replacedId)
+	}
+}
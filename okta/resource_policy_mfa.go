@@ -3,6 +3,7 @@ package okta
 import (
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -10,34 +11,56 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var (
+	factorEnrollSchema = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "OPTIONAL",
+		ValidateFunc: validation.StringInSlice([]string{"NOT_ALLOWED", "OPTIONAL", "REQUIRED"}, false),
+		Description:  "Requirements for use-initiated enrollment.",
+	}
+
+	factorConsentSchema = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "NONE",
+		ValidateFunc: validation.StringInSlice([]string{"NONE", "TERMS_OF_SERVICE"}, false),
+		Description:  "User consent type required before enrolling in the factor: NONE or TERMS_OF_SERVICE.",
+	}
+
+	policyFactorResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(factorCatalog, false),
+				Description:  "Factor key, see the factor catalog for supported values.",
+			},
+			"enroll":       factorEnrollSchema,
+			"consent_type": factorConsentSchema,
+		},
+	}
+)
+
 func getPolicyFactorSchema(key string) map[string]*schema.Schema {
 	// These are primitives to allow defaulting. Terraform still does not support aggregate defaults.
 	return map[string]*schema.Schema{
 		key: &schema.Schema{
-			Optional: true,
-			Type:     schema.TypeMap,
+			Optional:      true,
+			Type:          schema.TypeMap,
+			Deprecated:    "Configure this factor with a factor block instead.",
+			ConflictsWith: []string{"factor"},
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
-					"enroll": &schema.Schema{
-						Type:         schema.TypeString,
-						Optional:     true,
-						Default:      "OPTIONAL",
-						ValidateFunc: validation.StringInSlice([]string{"NOT_ALLOWED", "OPTIONAL", "REQUIRED"}, false),
-						Description:  "Requirements for use-initiated enrollment.",
-					},
-					"consent_type": &schema.Schema{
-						Type:         schema.TypeString,
-						Optional:     true,
-						Default:      "NONE",
-						ValidateFunc: validation.StringInSlice([]string{"NONE", "TERMS_OF_SERVICE"}, false),
-						Description:  "User consent type required before enrolling in the factor: NONE or TERMS_OF_SERVICE.",
-					},
+					"enroll":       factorEnrollSchema,
+					"consent_type": factorConsentSchema,
 				},
 			},
 		},
 	}
 }
 
+// Factors that used to have a dedicated attribute, they remain as deprecated aliases of the factor block.
 var factorProviders = []string{
 	"duo",
 	"fido_u2f",
@@ -63,16 +86,24 @@ func buildFactorProviders(target map[string]*schema.Schema) map[string]*schema.S
 		}
 	}
 
+	target["factor"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        policyFactorResource,
+		Description: "Factors users may enroll in. Factors that are not listed are not allowed.",
+	}
+
 	return target
 }
 
 func resourcePolicyMfa() *schema.Resource {
 	return &schema.Resource{
-		Exists: resourcePolicyExists,
-		Create: resourcePolicyMfaCreate,
-		Read:   resourcePolicyMfaRead,
-		Update: resourcePolicyMfaUpdate,
-		Delete: resourcePolicyMfaDelete,
+		Exists:        resourcePolicyMfaExists,
+		Create:        resourcePolicyMfaCreate,
+		Read:          resourcePolicyMfaRead,
+		Update:        resourcePolicyMfaUpdate,
+		Delete:        resourcePolicyMfaDelete,
+		CustomizeDiff: validateMfaPolicyFactors,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -89,37 +120,54 @@ func resourcePolicyMfaCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	policy := buildMfaPolicy(d, m)
-	err := createPolicy(d, m, policy)
+	template := buildMfaPolicy(d, m, nil)
+	policy, _, err := getSupplementFromMetadata(m).CreateMfaPolicy(template)
 	if err != nil {
+		return fmt.Errorf("[ERROR] Error Creating Policy: %v", err)
+	}
+	log.Printf("[INFO] Okta Policy Created: %+v. Adding Policy to Terraform.", policy.Policy)
+	d.SetId(policy.ID)
+
+	// Even if priority is invalid we want to add the policy to Terraform to reflect upstream.
+	if err = validatePriority(template.Priority, policy.Priority); err != nil {
+		return err
+	}
+
+	if err = policyActivate(d, m); err != nil {
 		return err
 	}
 
 	return resourcePolicyMfaRead(d, m)
 }
 
+func resourcePolicyMfaExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	policy, err := getMfaPolicy(d, m)
+
+	return err == nil && policy != nil, err
+}
+
 func resourcePolicyMfaRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[INFO] List Policy %v", d.Get("name").(string))
 
-	policy, err := getPolicy(d, m)
+	policy, err := getMfaPolicy(d, m)
 	if err != nil {
 		return err
 	}
-	syncFactor(d, "duo", policy.Settings.Factors.Duo)
-	syncFactor(d, "fido_u2f", policy.Settings.Factors.FidoU2f)
-	syncFactor(d, "fido_webauthn", policy.Settings.Factors.FidoWebauthn)
-	syncFactor(d, "google_otp", policy.Settings.Factors.GoogleOtp)
-	syncFactor(d, "okta_call", policy.Settings.Factors.OktaOtp)
-	syncFactor(d, "okta_otp", policy.Settings.Factors.OktaOtp)
-	syncFactor(d, "okta_password", policy.Settings.Factors.OktaPassword)
-	syncFactor(d, "okta_push", policy.Settings.Factors.OktaPush)
-	syncFactor(d, "okta_question", policy.Settings.Factors.OktaQuestion)
-	syncFactor(d, "okta_sms", policy.Settings.Factors.OktaSms)
-	syncFactor(d, "rsa_token", policy.Settings.Factors.RsaToken)
-	syncFactor(d, "symantec_vip", policy.Settings.Factors.SymantecVip)
-	syncFactor(d, "yubikey_token", policy.Settings.Factors.YubikeyToken)
 
-	return syncPolicyFromUpstream(d, policy)
+	var factors map[string]*articulateOkta.FactorProvider
+	if policy.Settings != nil {
+		factors = policy.Settings.Factors
+	}
+
+	if usesLegacyFactors(d) {
+		for _, key := range factorProviders {
+			syncFactor(d, key, factors[key])
+		}
+	} else if err := d.Set("factor", flattenPolicyFactors(d, factors)); err != nil {
+		return err
+	}
+
+	return syncPolicyFromUpstream(d, policy.Policy)
 }
 
 func resourcePolicyMfaUpdate(d *schema.ResourceData, m interface{}) error {
@@ -128,9 +176,28 @@ func resourcePolicyMfaUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Partial(true)
-	policy := buildMfaPolicy(d, m)
-	err := updatePolicy(d, m, policy)
+	current, err := getMfaPolicy(d, m)
+	if err != nil {
+		return err
+	}
+
+	var upstream map[string]*articulateOkta.FactorProvider
+	if current != nil && current.Settings != nil {
+		upstream = current.Settings.Factors
+	}
+
+	template := buildMfaPolicy(d, m, upstream)
+	policy, _, err := getSupplementFromMetadata(m).UpdateMfaPolicy(d.Id(), template)
 	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating Policy: %v", err)
+	}
+	// avoiding perpetual diffs by erroring when the configured priority is not valid and the API defaults it.
+	if err = validatePriority(template.Priority, policy.Priority); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Okta Policy Updated: %+v", policy.Policy)
+
+	if err = policyActivate(d, m); err != nil {
 		return err
 	}
 	d.Partial(false)
@@ -154,8 +221,8 @@ func resourcePolicyMfaDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-// create or update a password policy
-func buildMfaPolicy(d *schema.ResourceData, m interface{}) *articulateOkta.Policy {
+// create or update an mfa policy, upstream holds the factors of the existing policy and is nil on create
+func buildMfaPolicy(d *schema.ResourceData, m interface{}, upstream map[string]*articulateOkta.FactorProvider) *MfaPolicy {
 	client := getClientFromMetadata(m)
 
 	policy := client.Policies.MfaPolicy()
@@ -166,32 +233,60 @@ func buildMfaPolicy(d *schema.ResourceData, m interface{}) *articulateOkta.Polic
 	if priority, ok := d.GetOk("priority"); ok {
 		policy.Priority = priority.(int)
 	}
-
-	policy.Settings = &articulateOkta.PolicySettings{
-		Factors: &articulateOkta.Factors{
-			Duo:          buildFactorProvider(d, "duo"),
-			FidoU2f:      buildFactorProvider(d, "fido_u2f"),
-			FidoWebauthn: buildFactorProvider(d, "fido_webauthn"),
-			GoogleOtp:    buildFactorProvider(d, "google_otp"),
-			OktaCall:     buildFactorProvider(d, "okta_call"),
-			OktaOtp:      buildFactorProvider(d, "okta_otp"),
-			OktaPassword: buildFactorProvider(d, "okta_password"),
-			OktaPush:     buildFactorProvider(d, "okta_push"),
-			OktaQuestion: buildFactorProvider(d, "okta_question"),
-			OktaSms:      buildFactorProvider(d, "okta_sms"),
-			RsaToken:     buildFactorProvider(d, "rsa_token"),
-			SymantecVip:  buildFactorProvider(d, "symantec_vip"),
-			YubikeyToken: buildFactorProvider(d, "yubikey_token"),
-		},
-	}
 	policy.Conditions = &articulateOkta.PolicyConditions{
 		People: getGroups(d),
 	}
 
-	return &policy
+	factors := map[string]*articulateOkta.FactorProvider{}
+	if usesLegacyFactors(d) {
+		for _, key := range factorProviders {
+			if provider := buildFactorProvider(d, key); provider != nil {
+				factors[key] = provider
+			}
+		}
+	} else {
+		// Omitted factors keep whatever Okta has, so the ones removed from the factor blocks or still set upstream are
+		// disallowed explicitly. Factors the policy never had are left out, Okta rejects those not enabled in the org.
+		old, _ := d.GetChange("factor")
+		var disallowed []string
+		for _, raw := range old.(*schema.Set).List() {
+			disallowed = append(disallowed, raw.(map[string]interface{})["key"].(string))
+		}
+		for key := range upstream {
+			disallowed = append(disallowed, key)
+		}
+
+		for _, key := range disallowed {
+			factors[key] = &articulateOkta.FactorProvider{
+				Consent: articulateOkta.Consent{Type: "NONE"},
+				Enroll:  articulateOkta.Enroll{Self: "NOT_ALLOWED"},
+			}
+		}
+	}
+
+	for _, raw := range d.Get("factor").(*schema.Set).List() {
+		f := raw.(map[string]interface{})
+		factors[f["key"].(string)] = &articulateOkta.FactorProvider{
+			Consent: articulateOkta.Consent{Type: f["consent_type"].(string)},
+			Enroll:  articulateOkta.Enroll{Self: f["enroll"].(string)},
+		}
+	}
+
+	return &MfaPolicy{
+		Policy: &policy,
+		Settings: &MfaPolicySettings{
+			Factors: factors,
+		},
+	}
 }
 
 func buildFactorProvider(d *schema.ResourceData, key string) *articulateOkta.FactorProvider {
+	// Only the deprecated attributes that are actually configured are sent, otherwise their defaults would
+	// clobber what is configured in the factor block.
+	if _, ok := d.GetOk(key); !ok {
+		return nil
+	}
+
 	consent := d.Get(fmt.Sprintf("%s.consent_type", key)).(string)
 	enroll := d.Get(fmt.Sprintf("%s.enroll", key)).(string)
 
@@ -212,9 +307,69 @@ func buildFactorProvider(d *schema.ResourceData, key string) *articulateOkta.Fac
 	return provider
 }
 
+// The API lists every factor enabled in the org, those the policy does not allow are only tracked when they are
+// already in state. Otherwise simply enabling a factor in the org would show up as drift on every MFA policy.
+func flattenPolicyFactors(d *schema.ResourceData, factors map[string]*articulateOkta.FactorProvider) *schema.Set {
+	var tracked []string
+	for _, raw := range d.Get("factor").(*schema.Set).List() {
+		tracked = append(tracked, raw.(map[string]interface{})["key"].(string))
+	}
+
+	var flattened []interface{}
+	for key, f := range factors {
+		if f == nil || (f.Enroll.Self == "NOT_ALLOWED" && !contains(tracked, key)) {
+			continue
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"key":          key,
+			"enroll":       f.Enroll.Self,
+			"consent_type": f.Consent.Type,
+		})
+	}
+
+	return schema.NewSet(schema.HashResource(policyFactorResource), flattened)
+}
+
+// Grabs policy from upstream, if the resource does not exist the returned policy will be nil which is not considered an error
+func getMfaPolicy(d *schema.ResourceData, m interface{}) (*MfaPolicy, error) {
+	policy, resp, err := getSupplementFromMetadata(m).GetMfaPolicy(d.Id())
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	return policy, err
+}
+
 func syncFactor(d *schema.ResourceData, k string, f *articulateOkta.FactorProvider) {
 	if f != nil {
 		d.Set(fmt.Sprintf("%s.consent_type", k), f.Consent.Type)
 		d.Set(fmt.Sprintf("%s.enroll", k), f.Enroll.Self)
 	}
 }
+
+func usesLegacyFactors(d *schema.ResourceData) bool {
+	for _, key := range factorProviders {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensures the same factor is not configured twice in the factor block, the API would silently keep only one of them.
+func validateMfaPolicyFactors(d *schema.ResourceDiff, m interface{}) error {
+	var seen []string
+
+	for _, raw := range d.Get("factor").(*schema.Set).List() {
+		key := raw.(map[string]interface{})["key"].(string)
+		if contains(seen, key) {
+			return fmt.Errorf("factor %s is configured more than once", key)
+		}
+		seen = append(seen, key)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func deleteMfaPolicies(client *testClient) error {
@@ -17,6 +18,8 @@ func TestAccOktaMfaPolicy(t *testing.T) {
 	mgr := newFixtureManager(policyMfa)
 	config := mgr.GetFixtures("basic.tf", ri, t)
 	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	factorsConfig := mgr.GetFixtures("factors.tf", ri, t)
+	factorsUpdatedConfig := mgr.GetFixtures("factors_updated.tf", ri, t)
 	resourceName := fmt.Sprintf("%s.test", policyMfa)
 
 	resource.Test(t, resource.TestCase{
@@ -47,6 +50,44 @@ func TestAccOktaMfaPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "okta_sms.enroll", "OPTIONAL"),
 				),
 			},
+			{
+				Config: factorsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "factor.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "fido_u2f.%", "0"),
+				),
+			},
+			{
+				// The removed factor is disallowed, otherwise it would be read back and never converge
+				Config: factorsUpdatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensurePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "factor.#", "3"),
+					ensureMfaPolicyFactor(resourceName, "fido_u2f", "NOT_ALLOWED"),
+					ensureMfaPolicyFactor(resourceName, "okta_sms", "REQUIRED"),
+				),
+			},
 		},
 	})
 }
+
+func ensureMfaPolicyFactor(name, key, enroll string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		policy, _, err := getSupplementFromMetadata(testAccProvider.Meta()).GetMfaPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		factor, ok := policy.Settings.Factors[key]
+		if !ok || factor.Enroll.Self != enroll {
+			return fmt.Errorf("expected factor %s of policy %s to be %s, got %+v", key, rs.Primary.ID, enroll, factor)
+		}
+
+		return nil
+	}
+}
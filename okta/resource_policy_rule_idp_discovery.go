@@ -44,10 +44,10 @@ var userIdPatternResource = &schema.Resource{
 func resourcePolicyRuleIdpDiscovery() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourcePolicyRuleIdpDiscoveryExists,
-		Create:   resourcePolicyRuleIdpDiscoveryCreate,
+		Create:   withParentLock("policyid", resourcePolicyRuleIdpDiscoveryCreate),
 		Read:     resourcePolicyRuleIdpDiscoveryRead,
-		Update:   resourcePolicyRuleIdpDiscoveryUpdate,
-		Delete:   resourcePolicyRuleIdpDiscoveryDelete,
+		Update:   withParentLock("policyid", resourcePolicyRuleIdpDiscoveryUpdate),
+		Delete:   withParentLock("policyid", resourcePolicyRuleIdpDiscoveryDelete),
 		Importer: createPolicyRuleImporter(),
 
 		Schema: buildBaseRuleSchema(map[string]*schema.Schema{
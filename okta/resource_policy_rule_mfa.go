@@ -9,10 +9,10 @@ import (
 func resourcePolicyMfaRule() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourcePolicyRuleExists,
-		Create:   resourcePolicyMfaRuleCreate,
+		Create:   withParentLock("policyid", resourcePolicyMfaRuleCreate),
 		Read:     resourcePolicyMfaRuleRead,
-		Update:   resourcePolicyMfaRuleUpdate,
-		Delete:   resourcePolicyMfaRuleDelete,
+		Update:   withParentLock("policyid", resourcePolicyMfaRuleUpdate),
+		Delete:   withParentLock("policyid", resourcePolicyMfaRuleDelete),
 		Importer: createPolicyRuleImporter(),
 
 		Schema: buildRuleSchema(map[string]*schema.Schema{
@@ -12,10 +12,10 @@ import (
 func resourcePolicyPasswordRule() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourcePolicyRuleExists,
-		Create:   resourcePolicyPasswordRuleCreate,
+		Create:   withParentLock("policyid", resourcePolicyPasswordRuleCreate),
 		Read:     resourcePolicyPasswordRuleRead,
-		Update:   resourcePolicyPasswordRuleUpdate,
-		Delete:   resourcePolicyPasswordRuleDelete,
+		Update:   withParentLock("policyid", resourcePolicyPasswordRuleUpdate),
+		Delete:   withParentLock("policyid", resourcePolicyPasswordRuleDelete),
 		Importer: createPolicyRuleImporter(),
 
 		Schema: buildRuleSchema(map[string]*schema.Schema{
@@ -12,10 +12,10 @@ import (
 func resourcePolicySignonRule() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourcePolicyRuleExists,
-		Create:   resourcePolicySignonRuleCreate,
+		Create:   withParentLock("policyid", resourcePolicySignonRuleCreate),
 		Read:     resourcePolicySignonRuleRead,
-		Update:   resourcePolicySignonRuleUpdate,
-		Delete:   resourcePolicySignonRuleDelete,
+		Update:   withParentLock("policyid", resourcePolicySignonRuleUpdate),
+		Delete:   withParentLock("policyid", resourcePolicySignonRuleDelete),
 		Importer: createPolicyRuleImporter(),
 
 		Schema: buildRuleSchema(map[string]*schema.Schema{
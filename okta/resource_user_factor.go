@@ -0,0 +1,231 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Pre-enrolls a factor on behalf of a user, mostly useful for service accounts and kiosk users that never go
+// through the enrollment flow. Factors cannot be updated, any change enrolls a new one.
+func resourceUserFactor() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceUserFactorCreate,
+		Read:     resourceUserFactorRead,
+		Delete:   resourceUserFactorDelete,
+		Exists:   resourceUserFactorExists,
+		Importer: createNestedResourceImporter([]string{"user_id", "id"}),
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to enroll the factor for.",
+			},
+			"factor_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"call", "email", "question", "sms", "token:hardware", "token:hotp", "token:software:totp"},
+					false,
+				),
+			},
+			// provider is a reserved attribute name in Terraform
+			"factor_provider": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CUSTOM", "GOOGLE", "OKTA", "YUBICO"}, false),
+			},
+			"activate": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Auto-activate the factor on enrollment. Supported for call, email, sms and token:hotp factors.",
+			},
+			"email": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: matchEmailRegexp,
+			},
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"question": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Security question key, for instance disliked_food.",
+			},
+			"answer": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				StateFunc: hashSecret,
+			},
+			"shared_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				StateFunc:   hashSecret,
+				Description: "Known shared secret of a token:hotp factor.",
+			},
+			"factor_profile_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Custom factor profile ID, required for token:hotp factors.",
+			},
+			"pass_code": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				StateFunc:   hashSecret,
+				Description: "One time password generated by a YubiKey, used to enroll token:hardware factors.",
+			},
+			"credential_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Credential ID of the factor, for instance the YubiKey serial number.",
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"totp_shared_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Shared secret of a token:software:totp factor, only available at enrollment.",
+			},
+			"totp_qr_code_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "QR code of a token:software:totp factor, only available at enrollment.",
+			},
+		},
+	}
+}
+
+// Profile attributes each factor type needs to be enrolled
+var userFactorRequirements = map[string][]string{
+	"call":           []string{"phone_number"},
+	"email":          []string{"email"},
+	"question":       []string{"question", "answer"},
+	"sms":            []string{"phone_number"},
+	"token:hardware": []string{"pass_code"},
+	"token:hotp":     []string{"shared_secret", "factor_profile_id"},
+}
+
+func buildUserFactor(d *schema.ResourceData) *UserFactor {
+	factor := &UserFactor{
+		FactorType: d.Get("factor_type").(string),
+		Provider:   d.Get("factor_provider").(string),
+		Profile: &UserFactorProfile{
+			Answer:       d.Get("answer").(string),
+			CredentialId: d.Get("credential_id").(string),
+			Email:        d.Get("email").(string),
+			PhoneNumber:  d.Get("phone_number").(string),
+			Question:     d.Get("question").(string),
+			SharedSecret: d.Get("shared_secret").(string),
+		},
+	}
+
+	if passCode := d.Get("pass_code").(string); passCode != "" {
+		factor.Verify = &UserFactorVerify{PassCode: passCode}
+	}
+
+	return factor
+}
+
+func resourceUserFactorCreate(d *schema.ResourceData, m interface{}) error {
+	factorType := d.Get("factor_type").(string)
+	if err := conditionalRequire(d, userFactorRequirements[factorType], fmt.Sprintf("required for %s factors", factorType)); err != nil {
+		return err
+	}
+
+	userId := d.Get("user_id").(string)
+	factor, _, err := getSupplementFromMetadata(m).EnrollUserFactor(userId, *buildUserFactor(d), d.Get("activate").(bool), d.Get("factor_profile_id").(string))
+	if err != nil {
+		return fmt.Errorf("failed to enroll %s factor for user %s: %v", factorType, userId, err)
+	}
+	d.SetId(factor.Id)
+
+	// The TOTP secret is only ever returned at enrollment
+	if factor.Embedded != nil && factor.Embedded.Activation != nil {
+		activation := factor.Embedded.Activation
+		d.Set("totp_shared_secret", activation.SharedSecret)
+		if activation.Links != nil && activation.Links.QrCode != nil {
+			d.Set("totp_qr_code_url", activation.Links.QrCode.Href)
+		}
+	}
+
+	return resourceUserFactorRead(d, m)
+}
+
+func resourceUserFactorExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	factor, err := fetchUserFactor(d, m)
+
+	return err == nil && factor != nil, err
+}
+
+func resourceUserFactorRead(d *schema.ResourceData, m interface{}) error {
+	factor, err := fetchUserFactor(d, m)
+	if err != nil {
+		return err
+	}
+
+	if factor == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("factor_type", factor.FactorType)
+	d.Set("factor_provider", factor.Provider)
+	d.Set("status", factor.Status)
+
+	if factor.Profile != nil {
+		d.Set("credential_id", factor.Profile.CredentialId)
+		if factor.Profile.Email != "" {
+			d.Set("email", factor.Profile.Email)
+		}
+		if factor.Profile.PhoneNumber != "" {
+			d.Set("phone_number", factor.Profile.PhoneNumber)
+		}
+		if factor.Profile.Question != "" {
+			d.Set("question", factor.Profile.Question)
+		}
+	}
+
+	return nil
+}
+
+func resourceUserFactorDelete(d *schema.ResourceData, m interface{}) error {
+	resp, err := getSupplementFromMetadata(m).DeleteUserFactor(d.Get("user_id").(string), d.Id())
+
+	return suppressErrorOn404(resp, err)
+}
+
+func fetchUserFactor(d *schema.ResourceData, m interface{}) (*UserFactor, error) {
+	factor, resp, err := getSupplementFromMetadata(m).GetUserFactor(d.Get("user_id").(string), d.Id())
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	return factor, err
+}
@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func userFactorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		client := getSupplementFromMetadata(testAccProvider.Meta())
+		_, resp, err := client.GetUserFactor(rs.Primary.Attributes["user_id"], rs.Primary.ID)
+		if resp != nil && is404(resp.StatusCode) {
+			return fmt.Errorf("factor %s does not exist", rs.Primary.ID)
+		}
+
+		return err
+	}
+}
+
+func TestAccOktaUserFactor(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactor)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userFactor)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					userFactorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "factor_type", "email"),
+					resource.TestCheckResourceAttr(resourceName, "factor_provider", "OKTA"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/okta/okta-sdk-golang/okta"
+	"github.com/okta/okta-sdk-golang/okta/cache"
+)
+
+// Stand-ins replace Okta for the endpoints acceptance tests cannot exercise, such as provisioning connections or
+// third party factor credentials. Everything else is tested against a real org.
+
+func writeStandInError(w http.ResponseWriter, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"errorCode":"E0000000","errorSummary":"%s"}`, http.StatusText(status))
+}
+
+// Providers whose API supplement talks to the given server instead of Okta
+func standInProviders(server *httptest.Server) map[string]terraform.ResourceProvider {
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config := &okta.Config{}
+		config.Okta.Client.OrgUrl = server.URL
+		config.Okta.Client.Token = d.Get("api_token").(string)
+
+		return &Config{
+			supplementClient: &ApiSupplement{
+				baseURL:         server.URL,
+				client:          server.Client(),
+				token:           config.Okta.Client.Token,
+				requestExecutor: okta.NewRequestExecutor(server.Client(), cache.NewNoOpCache(), config),
+			},
+		}, nil
+	}
+
+	return map[string]terraform.ResourceProvider{"okta": provider}
+}
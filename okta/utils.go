@@ -1,6 +1,8 @@
 package okta
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -280,8 +282,9 @@ func getMapString(m map[string]interface{}, key string) string {
 
 // Useful shortcut for suppressing errors from Okta's SDK when a resource does not exist. Usually used during deletion
 // of nested resources.
+// The response is nil when the request never reached Okta, that error is kept
 func suppressErrorOn404(resp *okta.Response, err error) error {
-	if resp.StatusCode == http.StatusNotFound {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
 		return nil
 	}
 
@@ -330,6 +333,18 @@ func getRequestExecutor(m interface{}) *okta.RequestExecutor {
 	return okta.NewRequestExecutor(nil, cache.NewNoOpCache(), config)
 }
 
+// Used as a StateFunc on write-only secrets so only a digest of the value lands in state. The raw value is still
+// available via d.Get during apply.
+func hashSecret(val interface{}) string {
+	secret := val.(string)
+	if secret == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
 func is404(status int) bool {
 	return status == http.StatusNotFound
 }